@@ -0,0 +1,205 @@
+package sampgo
+
+import "fmt"
+
+// Actor implements OO actors, static NPCs such as shopkeepers.
+type Actor struct {
+	ID int
+}
+
+// ActorDamage describes a player damaging an actor.
+type ActorDamage struct {
+	Player   Player
+	Amount   float32
+	Weapon   int
+	BodyPart int
+}
+
+var actorsHooked bool
+
+// NewActor creates an actor with the skin modelid.
+func NewActor(modelid int, x, y, z, rotation float32) (*Actor, error) {
+	if modelid < 0 || modelid > 311 || modelid == 74 {
+		return nil, fmt.Errorf("%w %d", ErrInvalidSkin, modelid)
+	}
+
+	a := &Actor{ID: CreateActor(modelid, x, y, z, rotation)}
+	if a.ID == InvalidActorId {
+		return nil, fmt.Errorf("can not create actor: %w", ErrLimitReached)
+	}
+	return a, nil
+}
+
+func (a *Actor) GetID() int {
+	return a.ID
+}
+
+// Destroy destroys the actor and removes its handlers.
+func (a *Actor) Destroy() error {
+	if !DestroyActor(a.ID) {
+		return actorError(a.ID, ErrInvalidActor)
+	}
+
+	for _, name := range []string{"damage", "streamIn", "streamOut"} {
+		removeEvent(actorEventName(a.ID, name))
+	}
+	return nil
+}
+
+func (a *Actor) IsValid() bool {
+	return IsValidActor(a.ID)
+}
+
+// IsStreamedIn reports whether the actor is streamed in for the player.
+func (a *Actor) IsStreamedIn(p *Player) bool {
+	return IsActorStreamedIn(a.ID, p.ID)
+}
+
+func (a *Actor) GetPos() (x, y, z float32, err error) {
+	if !GetActorPos(a.ID, &x, &y, &z) {
+		err = actorError(a.ID, ErrInvalidActor)
+	}
+	return
+}
+
+func (a *Actor) SetPos(x, y, z float32) error {
+	if !SetActorPos(a.ID, x, y, z) {
+		return actorError(a.ID, ErrInvalidActor)
+	}
+	return nil
+}
+
+func (a *Actor) GetFacingAngle() (angle float32, err error) {
+	if !GetActorFacingAngle(a.ID, &angle) {
+		err = actorError(a.ID, ErrInvalidActor)
+	}
+	return
+}
+
+func (a *Actor) SetFacingAngle(angle float32) error {
+	if !SetActorFacingAngle(a.ID, angle) {
+		return actorError(a.ID, ErrInvalidActor)
+	}
+	return nil
+}
+
+func (a *Actor) GetHealth() (health float32, err error) {
+	if !GetActorHealth(a.ID, &health) {
+		err = actorError(a.ID, ErrInvalidActor)
+	}
+	return
+}
+
+func (a *Actor) SetHealth(health float32) error {
+	if !SetActorHealth(a.ID, health) {
+		return actorError(a.ID, ErrInvalidActor)
+	}
+	return nil
+}
+
+func (a *Actor) IsInvulnerable() bool {
+	return IsActorInvulnerable(a.ID)
+}
+
+// SetInvulnerable sets whether the actor can be damaged. Actors are
+// invulnerable by default, the damage handlers are only called for vulnerable
+// actors.
+func (a *Actor) SetInvulnerable(invulnerable bool) error {
+	if !SetActorInvulnerable(a.ID, invulnerable) {
+		return actorError(a.ID, ErrInvalidActor)
+	}
+	return nil
+}
+
+func (a *Actor) GetVirtualWorld() (int, error) {
+	if !IsValidActor(a.ID) {
+		return 0, actorError(a.ID, ErrInvalidActor)
+	}
+	return GetActorVirtualWorld(a.ID), nil
+}
+
+func (a *Actor) SetVirtualWorld(world int) error {
+	if !SetActorVirtualWorld(a.ID, world) {
+		return actorError(a.ID, ErrInvalidActor)
+	}
+	return nil
+}
+
+// ApplyAnimation plays an animation. duration is in milliseconds, 0 loops it
+// forever.
+func (a *Actor) ApplyAnimation(animLib, animName string, delta float32, loop, lockX, lockY, freeze bool, duration int) error {
+	if !ApplyActorAnimation(a.ID, animLib, animName, delta, loop, lockX, lockY, freeze, duration) {
+		return actorError(a.ID, ErrInvalidActor)
+	}
+	return nil
+}
+
+func (a *Actor) ClearAnimations() error {
+	if !ClearActorAnimations(a.ID) {
+		return actorError(a.ID, ErrInvalidActor)
+	}
+	return nil
+}
+
+// OnDamage registers a handler called when a player damages the actor, fed by
+// the playerGiveDamageActor event. The handlers are removed when the actor is
+// destroyed.
+func (a *Actor) OnDamage(handler func(damage ActorDamage) bool) *Subscription {
+	return a.on("damage", handler)
+}
+
+// OnStreamIn registers a handler called when the actor is streamed in for a
+// player.
+func (a *Actor) OnStreamIn(handler func(p Player) bool) *Subscription {
+	return a.on("streamIn", handler)
+}
+
+// OnStreamOut registers a handler called when the actor is streamed out for a
+// player.
+func (a *Actor) OnStreamOut(handler func(p Player) bool) *Subscription {
+	return a.on("streamOut", handler)
+}
+
+func (a *Actor) on(name string, handler interface{}) *Subscription {
+	hookActors()
+	return insert(actorEventName(a.ID, name), &event{Handler: handler, Type: Repeat, Priority: PriorityNormal})
+}
+
+func actorEventName(actorID int, name string) string {
+	return fmt.Sprintf("%s%d:%s", actorPrefix, actorID, name)
+}
+
+// hookActors registers the handlers feeding the per-actor handlers the first
+// time one is added.
+func hookActors() {
+	if actorsHooked {
+		return
+	}
+	actorsHooked = true
+
+	_, _ = OnPlayerGiveDamageActor(func(p Player, actorID int, amount float32, weapon int, bodyPart int) bool {
+		damage := ActorDamage{Player: p, Amount: amount, Weapon: weapon, BodyPart: bodyPart}
+		return dispatch(actorEventName(actorID, "damage"), true, func(handler interface{}) (bool, bool) {
+			fn, ok := handler.(func(ActorDamage) bool)
+			if !ok {
+				return false, false
+			}
+			return fn(damage), true
+		})
+	})
+
+	streamed := func(name string) func(actorID, playerID int) bool {
+		return func(actorID, playerID int) bool {
+			p := Player{ID: playerID}
+			return dispatch(actorEventName(actorID, name), true, func(handler interface{}) (bool, bool) {
+				fn, ok := handler.(func(Player) bool)
+				if !ok {
+					return false, false
+				}
+				return fn(p), true
+			})
+		}
+	}
+	_, _ = OnActorStreamIn(streamed("streamIn"))
+	_, _ = OnActorStreamOut(streamed("streamOut"))
+}
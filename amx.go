@@ -0,0 +1,348 @@
+package sampgo
+
+/*
+#cgo windows CFLAGS: -I./lib -I./lib/amx -Wno-attributes -Wno-implicit-function-declaration
+#cgo windows CFLAGS: -DHAVE_INTTYPES_H -DHAVE_MALLOC_H -DHAVE_STDINT_H -DWIN32
+#cgo windows LDFLAGS: -Wl,--subsystem,windows,--kill-at
+
+#cgo linux CFLAGS: -I./lib -I./lib/amx -Wno-attributes -Wno-implicit-function-declaration
+#cgo linux CFLAGS: -DHAVE_INTTYPES_H -DHAVE_MALLOC_H -DHAVE_STDINT_H -DLINUX -D_GNU_SOURCE
+#cgo linux LDFLAGS: -ldl
+
+#ifndef GOLANG_APP
+#define GOLANG_APP
+
+#include "main.h"
+
+#endif
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"unsafe"
+)
+
+// ErrInvalidAddress is returned when an address lies outside a script's memory.
+var ErrInvalidAddress = errors.New("invalid AMX address")
+
+// maxCells bounds the array type used to view AMX memory as a Go slice.
+const maxCells = 1 << 26
+
+// cells returns a slice viewing n cells starting at ptr.
+func cells(ptr *C.cell, n int) []C.cell {
+	if n == 0 {
+		return nil
+	}
+	return (*[maxCells]C.cell)(unsafe.Pointer(ptr))[:n:n]
+}
+
+// nativeParams returns the params array passed to a native, including
+// params[0] which holds the size of the arguments in bytes.
+func nativeParams(params *C.cell) []C.cell {
+	return cells(params, int(*params)/C.sizeof_cell+1)
+}
+
+// amxAddr resolves an address in the AMX data section to a pointer.
+func amxAddr(amx *C.AMX, addr C.cell) (*C.cell, error) {
+	var phys *C.cell
+	if err := C.amx_GetAddr(amx, addr, &phys); err != C.AMX_ERR_NONE || phys == nil {
+		return nil, fmt.Errorf("%#x (error %d): %w", int32(addr), int(err), ErrInvalidAddress)
+	}
+	return phys, nil
+}
+
+// amxCells returns a view of n cells starting at addr, checking that all of
+// them lie inside the script's memory.
+func amxCells(amx *C.AMX, addr C.cell, n int) ([]C.cell, error) {
+	if err := checkCellRange(Cell(addr), n, Cell(amx.hea), Cell(amx.stk), Cell(amx.stp)); err != nil {
+		return nil, err
+	}
+
+	phys, err := amxAddr(amx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return cells(phys, n), nil
+}
+
+// checkCellRange checks that the n cells starting at addr lie either in the
+// data and heap, below hea, or in the stack, from stk up to stp. Like
+// amx_GetAddr, it rejects the unused space between the heap and the stack.
+func checkCellRange(addr Cell, n int, hea, stk, stp Cell) error {
+	if n < 1 || n > math.MaxInt32/C.sizeof_cell {
+		return fmt.Errorf("invalid number of cells %d", n)
+	}
+
+	start := int64(addr)
+	end := start + int64(n)*C.sizeof_cell
+	if start < 0 || (end > int64(hea) && (start < int64(stk) || end > int64(stp))) {
+		return fmt.Errorf("%d cells at %#x: %w", n, int32(addr), ErrInvalidAddress)
+	}
+	return nil
+}
+
+// amxString reads the packed or unpacked string at phys.
+func amxString(phys *C.cell) string {
+	var length C.int
+	C.amx_StrLen(phys, &length)
+	if length == 0 {
+		return ""
+	}
+
+	size := C.size_t(length + 1)
+	buf := (*C.char)(C.malloc(size))
+	defer C.free(unsafe.Pointer(buf))
+
+	C.amx_GetString(buf, phys, 0, size)
+	return C.GoString(buf)
+}
+
+func cellToFloat(c C.cell) float32 {
+	return math.Float32frombits(uint32(c))
+}
+
+func floatToCell(f float32) C.cell {
+	return C.cell(int32(math.Float32bits(f)))
+}
+
+func boolToCell(b bool) C.cell {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// argSpec is a single argument of a Pawn format string such as "is&fa[3]".
+type argSpec struct {
+	kind byte
+	ref  bool
+	size int
+}
+
+// parseFormat parses a Pawn format string. Supported specifiers are i and d
+// (int), b (bool), c (rune), f (float32), s (string) and a[n] ([]int of n
+// cells). Prefixing a specifier with & passes it by reference, so a change
+// made by the Go handler is written back to the AMX; by-reference values are
+// passed as pointers, by-reference arrays as slices.
+func parseFormat(format string) ([]argSpec, error) {
+	var specs []argSpec
+
+	for i := 0; i < len(format); i++ {
+		var spec argSpec
+
+		if format[i] == '&' {
+			spec.ref = true
+			i++
+			if i == len(format) {
+				return nil, fmt.Errorf("format %q ends with &", format)
+			}
+		}
+
+		spec.kind = format[i]
+		switch spec.kind {
+		case 'i', 'd', 'b', 'c', 'f':
+		case 's':
+			if spec.ref {
+				return nil, fmt.Errorf("format %q: strings can not be passed by reference", format)
+			}
+		case 'a':
+			if i+1 >= len(format) || format[i+1] != '[' {
+				return nil, fmt.Errorf("format %q: array at %d is missing its [size]", format, i)
+			}
+			end := i + 2
+			for end < len(format) && format[end] != ']' {
+				end++
+			}
+			if end == len(format) {
+				return nil, fmt.Errorf("format %q: unterminated array size at %d", format, i)
+			}
+			size, err := strconv.Atoi(format[i+2 : end])
+			if err != nil || size < 1 {
+				return nil, fmt.Errorf("format %q: invalid array size %q", format, format[i+2:end])
+			}
+			spec.size = size
+			i = end
+		default:
+			return nil, fmt.Errorf("format %q: unknown specifier %q", format, spec.kind)
+		}
+
+		specs = append(specs, spec)
+	}
+
+	return specs, nil
+}
+
+// read converts the argument passed at addr into its Go value. Variadic
+// arguments are always passed by reference in Pawn, so addr is an address
+// even for plain values.
+func (spec argSpec) read(amx *C.AMX, addr C.cell) (interface{}, error) {
+	if spec.kind == 'a' {
+		src, err := amxCells(amx, addr, spec.size)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make([]int, spec.size)
+		for i, c := range src {
+			values[i] = int(c)
+		}
+		return values, nil
+	}
+
+	phys, err := amxAddr(amx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.kind == 's' {
+		return amxString(phys), nil
+	}
+
+	value := *phys
+	switch spec.kind {
+	case 'b':
+		b := value != 0
+		if spec.ref {
+			return &b, nil
+		}
+		return b, nil
+	case 'c':
+		r := rune(value)
+		if spec.ref {
+			return &r, nil
+		}
+		return r, nil
+	case 'f':
+		f := cellToFloat(value)
+		if spec.ref {
+			return &f, nil
+		}
+		return f, nil
+	default:
+		n := int(value)
+		if spec.ref {
+			return &n, nil
+		}
+		return n, nil
+	}
+}
+
+// write stores a by-reference argument back at addr.
+func (spec argSpec) write(amx *C.AMX, addr C.cell, value interface{}) error {
+	if !spec.ref {
+		return nil
+	}
+
+	if v, ok := value.([]int); ok {
+		dest, err := amxCells(amx, addr, spec.size)
+		if err != nil {
+			return err
+		}
+
+		for i := range dest {
+			if i < len(v) {
+				dest[i] = C.cell(v[i])
+			}
+		}
+		return nil
+	}
+
+	phys, err := amxAddr(amx, addr)
+	if err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case *int:
+		*phys = C.cell(*v)
+	case *bool:
+		*phys = boolToCell(*v)
+	case *rune:
+		*phys = C.cell(*v)
+	case *float32:
+		*phys = floatToCell(*v)
+	default:
+		return fmt.Errorf("can not write %T back to the AMX", value)
+	}
+
+	return nil
+}
+
+// resultToCell converts the value returned by an event handler into the cell
+// returned to Pawn. When dest is given, the result is converted with
+// resultString and copied into dest, and its length is returned.
+func resultToCell(result interface{}, dest *C.cell, destSize int) (C.cell, error) {
+	if dest != nil {
+		str, err := resultString(result)
+		if len(str) >= destSize {
+			str = str[:destSize-1]
+		}
+
+		cstr := C.CString(str)
+		defer C.free(unsafe.Pointer(cstr))
+		C.amx_SetString(dest, cstr, 0, 0, C.size_t(destSize))
+
+		return C.cell(len(str)), err
+	}
+
+	switch v := result.(type) {
+	case nil:
+		return 0, nil
+	case bool:
+		return boolToCell(v), nil
+	case int:
+		return C.cell(v), nil
+	case int32:
+		return C.cell(v), nil
+	case float32:
+		return floatToCell(v), nil
+	case float64:
+		return floatToCell(float32(v)), nil
+	case string:
+		return C.cell(len(v)), fmt.Errorf("string results need sampgo_CallEventStr")
+	}
+
+	return 0, fmt.Errorf("can not return %T to Pawn", result)
+}
+
+// resultString converts the value returned by an event handler called with
+// sampgo_CallEventStr. Only strings and fmt.Stringer values are converted, nil
+// results in an empty string.
+func resultString(result interface{}) (string, error) {
+	switch v := result.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case fmt.Stringer:
+		return v.String(), nil
+	}
+
+	return "", fmt.Errorf("can not return %T as a string to Pawn", result)
+}
+
+// Cell is a single Pawn cell.
+type Cell int32
+
+// Float reinterprets the cell as a Pawn Float.
+func (c Cell) Float() float32 {
+	return math.Float32frombits(uint32(c))
+}
+
+// Bool reports whether the cell is non-zero.
+func (c Cell) Bool() bool {
+	return c != 0
+}
+
+// FloatCell returns the cell holding the Pawn Float f.
+func FloatCell(f float32) Cell {
+	return Cell(int32(math.Float32bits(f)))
+}
+
+// BoolCell returns 1 for true and 0 for false.
+func BoolCell(b bool) Cell {
+	return Cell(boolToCell(b))
+}
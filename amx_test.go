@@ -0,0 +1,201 @@
+package sampgo
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		want   []argSpec
+	}{
+		{"", nil},
+		{"i", []argSpec{{kind: 'i'}}},
+		{"idbcfs", []argSpec{{kind: 'i'}, {kind: 'd'}, {kind: 'b'}, {kind: 'c'}, {kind: 'f'}, {kind: 's'}}},
+		{"a[3]", []argSpec{{kind: 'a', size: 3}}},
+		{"&i&f&b&c&d", []argSpec{{kind: 'i', ref: true}, {kind: 'f', ref: true}, {kind: 'b', ref: true}, {kind: 'c', ref: true}, {kind: 'd', ref: true}}},
+		{"&a[12]", []argSpec{{kind: 'a', ref: true, size: 12}}},
+		{"sa[2]&fi", []argSpec{{kind: 's'}, {kind: 'a', size: 2}, {kind: 'f', ref: true}, {kind: 'i'}}},
+	}
+
+	for _, tt := range tests {
+		got, err := parseFormat(tt.format)
+		if err != nil {
+			t.Errorf("parseFormat(%q) returned error: %v", tt.format, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseFormat(%q) = %+v, want %+v", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestParseFormatErrors(t *testing.T) {
+	tests := []string{
+		"&",
+		"i&",
+		"&s",
+		"a",
+		"ai",
+		"a[",
+		"a[3",
+		"a[]",
+		"a[0]",
+		"a[-1]",
+		"a[x]",
+		"x",
+		"i?",
+		"[3]",
+	}
+
+	for _, format := range tests {
+		if specs, err := parseFormat(format); err == nil {
+			t.Errorf("parseFormat(%q) = %+v, want error", format, specs)
+		}
+	}
+}
+
+func TestFloatCell(t *testing.T) {
+	tests := []float32{0, 1, -1, 0.5, 3.14159, -273.15, math.MaxFloat32, math.SmallestNonzeroFloat32, float32(math.Inf(1)), float32(math.Inf(-1))}
+
+	for _, f := range tests {
+		if got := FloatCell(f).Float(); got != f {
+			t.Errorf("FloatCell(%v).Float() = %v", f, got)
+		}
+		if got := cellToFloat(floatToCell(f)); got != f {
+			t.Errorf("cellToFloat(floatToCell(%v)) = %v", f, got)
+		}
+	}
+
+	if got := FloatCell(1.0); got != 0x3F800000 {
+		t.Errorf("FloatCell(1.0) = %#x, want 0x3f800000", int32(got))
+	}
+	if got := FloatCell(float32(math.NaN())).Float(); got == got {
+		t.Errorf("FloatCell(NaN).Float() = %v, want NaN", got)
+	}
+}
+
+func TestBoolCell(t *testing.T) {
+	if got := BoolCell(true); got != 1 {
+		t.Errorf("BoolCell(true) = %d, want 1", got)
+	}
+	if got := BoolCell(false); got != 0 {
+		t.Errorf("BoolCell(false) = %d, want 0", got)
+	}
+
+	for _, b := range []bool{true, false} {
+		if got := BoolCell(b).Bool(); got != b {
+			t.Errorf("BoolCell(%v).Bool() = %v", b, got)
+		}
+	}
+	for _, c := range []Cell{1, -1, 2, 0x7FFFFFFF} {
+		if !c.Bool() {
+			t.Errorf("Cell(%d).Bool() = false, want true", c)
+		}
+	}
+}
+
+func TestResultToCell(t *testing.T) {
+	tests := []struct {
+		result interface{}
+		want   Cell
+	}{
+		{nil, 0},
+		{true, 1},
+		{false, 0},
+		{42, 42},
+		{-7, -7},
+		{int32(1 << 30), 1 << 30},
+		{float32(1.5), FloatCell(1.5)},
+		{float64(-2.25), FloatCell(-2.25)},
+	}
+
+	for _, tt := range tests {
+		got, err := resultToCell(tt.result, nil, 0)
+		if err != nil {
+			t.Errorf("resultToCell(%#v) returned error: %v", tt.result, err)
+			continue
+		}
+		if Cell(got) != tt.want {
+			t.Errorf("resultToCell(%#v) = %d, want %d", tt.result, Cell(got), tt.want)
+		}
+	}
+
+	for _, result := range []interface{}{"text", []int{1}, struct{}{}} {
+		if _, err := resultToCell(result, nil, 0); err == nil {
+			t.Errorf("resultToCell(%#v) returned no error", result)
+		}
+	}
+}
+
+func TestResultString(t *testing.T) {
+	tests := []struct {
+		result interface{}
+		want   string
+	}{
+		// Handlers returning nothing result in "" or, from func() interface{},
+		// in nil.
+		{nil, ""},
+		{"", ""},
+		{"text", "text"},
+		{1500 * time.Millisecond, "1.5s"},
+	}
+
+	for _, tt := range tests {
+		got, err := resultString(tt.result)
+		if err != nil {
+			t.Errorf("resultString(%#v) returned error: %v", tt.result, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("resultString(%#v) = %q, want %q", tt.result, got, tt.want)
+		}
+	}
+
+	for _, result := range []interface{}{true, 42, float32(1.5), []string{"a"}} {
+		if str, err := resultString(result); err == nil || str != "" {
+			t.Errorf("resultString(%#v) = %q, %v, want an error", result, str, err)
+		}
+	}
+}
+
+func TestCheckCellRange(t *testing.T) {
+	// Data and heap below 0x100, unused space up to the stack at 0x200, which
+	// ends at 0x300.
+	const hea, stk, stp = 0x100, 0x200, 0x300
+
+	tests := []struct {
+		addr Cell
+		n    int
+		ok   bool
+	}{
+		{0, 1, true},
+		{0, 64, true},
+		{0xFC, 1, true},
+		{0x200, 64, true},
+		{0x2FC, 1, true},
+		{0xFC, 2, false},
+		{0x100, 1, false},
+		{0x1FC, 1, false},
+		{0x1FC, 2, false},
+		{0xF0, 0x100, false},
+		{0x2FC, 2, false},
+		{0x300, 1, false},
+		{-4, 1, false},
+		{-4, 2, false},
+		{0, 0, false},
+		{0, -1, false},
+		{0, 1 << 30, false},
+		{0x7FFFFFFC, 2, false},
+	}
+
+	for _, tt := range tests {
+		err := checkCellRange(tt.addr, tt.n, hea, stk, stp)
+		if (err == nil) != tt.ok {
+			t.Errorf("checkCellRange(%#x, %d) = %v, want ok %v", tt.addr, tt.n, err, tt.ok)
+		}
+	}
+}
@@ -17,893 +17,729 @@ package sampgo
 #endif
 */
 import "C"
-import (
-	"fmt"
-	"unsafe"
-)
 
+// callEvent calls the handlers of an event raised from Pawn. The arguments start
+// at params[first], a string result is copied into dest if it is not nil.
+//
 //export callEvent
-func callEvent(amx *C.AMX, funcName *C.char_t, format *C.char_t, params []C.cell) bool {
+func callEvent(amx *C.AMX, funcName *C.char_t, format *C.char_t, params *C.cell, first C.int, dest *C.cell, destSize C.int) C.cell {
 	name := C.GoString(C.constToNonConst(funcName))
 	specifiers := C.GoString(C.constToNonConst(format))
 
-	evt, ok := events[name]
-	if !ok {
-		_ = Print(fmt.Sprintf("sampgo: Called an event ('%s') that is not registered by sampgo.", name))
-		return false
+	if len(events[name]) == 0 {
+		logger.Warn("Event called from Pawn is not registered", "event", name)
+		return 0
 	}
 
-	_ = Print("callEvent (1)")
-	specifiersLen := len(specifiers)
-
-	if specifiersLen == 0 {
-		fn, ok := evt.Handler.(func())
-		if !ok {
-			_ = Print(fmt.Sprintf("sampgo: Event ('%s') failed to call", name))
-			return false
-		}
-		_ = Print("callEvent (2)")
-		fn()
-	} else {
-		fn, ok := evt.Handler.(func([]interface{}))
-		if !ok {
-			_ = Print(fmt.Sprintf("sampgo: Event ('%s') failed to call", name))
-			return false
-		}
-		in := make([]interface{}, specifiersLen)
-		param_offset := 0
-		for i := 0; i < specifiersLen; i++ {
-			var index int = i + param_offset + 3
-			switch specifiers[i] {
-			case 'i', 'd':
-				_ = Print("It is an int")
-				in[i] = int(params[index])
-			case 'f':
-				_ = Print("It is a float")
-				in[i] = float32(params[index])
-			case 's':
-				_ = Print("It is a string")
-				var maddr *C.cell
-				var len C.int = 0
-				if C.amx_GetAddr(amx, params[index], &maddr) == C.AMX_ERR_NONE || &maddr != nil {
-					C.amx_StrLen(maddr, &len)
-					len++
-					sval := C.malloc(C.uint(C.sizeof_char * (len)))
-					defer C.free(unsafe.Pointer(sval))
-					param_offset += int(len)
-					if C.amx_GetString((*C.char)(sval), maddr, C.int(0), C.uint(len)) == C.AMX_ERR_NONE {
-						in[i] = C.GoString((*C.char)(sval))
-					}
-				}
+	specs, err := parseFormat(specifiers)
+	if err != nil {
+		logger.Error("Event has an invalid format", "event", name, "error", err)
+		return 0
+	}
+
+	args := nativeParams(params)[first:]
+	if len(args) != len(specs) {
+		logger.Error("Event was called with the wrong number of arguments", "event", name, "format", specifiers, "given", len(args), "expected", len(specs))
+		return 0
+	}
+
+	in := make([]interface{}, len(specs))
+	for i, spec := range specs {
+		in[i], err = spec.read(amx, args[i])
+		if err != nil {
+			logger.Error("Event argument could not be read", "event", name, "argument", i, "error", err)
+			return 0
+		}
+	}
+
+	// Handlers without a result return true, or an empty string when called
+	// with sampgo_CallEventStr.
+	var result interface{} = true
+	if dest != nil {
+		result = ""
+	}
+	called := false
+	dispatch(name, false, func(handler interface{}) (bool, bool) {
+		switch fn := handler.(type) {
+		case func():
+			if len(specs) != 0 {
+				return false, false
+			}
+			fn()
+		case func([]interface{}):
+			if len(specs) == 0 {
+				return false, false
+			}
+			fn(in)
+		case func() interface{}:
+			if len(specs) != 0 {
+				return false, false
 			}
+			result = fn()
+		case func([]interface{}) interface{}:
+			if len(specs) == 0 {
+				return false, false
+			}
+			result = fn(in)
+		case *typedHandler:
+			if err := fn.check(specs); err != nil {
+				logger.Error("Event was called with a format that does not match its handler", "event", name, "format", specifiers, "error", err)
+				return false, false
+			}
+			value := fn.call(in)
+			if dest == nil || fn.returnsValue() {
+				result = value
+			}
+		default:
+			return false, false
 		}
-		_ = Print("callEvent (2)")
-		fn(in)
+		called = true
+		return true, true
+	})
+
+	if !called {
+		logger.Warn("Event has no handler taking its arguments", "event", name, "format", specifiers)
+		return 0
 	}
-	return true
-}
 
-//export onGameModeInit
-func onGameModeInit() bool {
-	evt, ok := events["goModeInit"]
-	if !ok {
-		return false
+	for i, spec := range specs {
+		if err := spec.write(amx, args[i], in[i]); err != nil {
+			logger.Error("Event argument could not be written back", "event", name, "argument", i, "error", err)
+		}
 	}
 
-	fn, ok := evt.Handler.(func() bool)
-	if !ok {
-		return false
+	ret, err := resultToCell(result, dest, int(destSize))
+	if err != nil {
+		logger.Error("Event result could not be passed to Pawn", "event", name, "error", err)
 	}
-	fn()
-	return true
+
+	logger.Debug("Event called from Pawn", "event", name, "format", specifiers, "result", int32(ret))
+	return ret
+}
+
+//export onGameModeInit
+func onGameModeInit() bool {
+	return dispatch("goModeInit", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func() bool)
+		if !ok {
+			return false, false
+		}
+		return fn(), true
+	})
 }
 
 //export onGameModeExit
 func onGameModeExit() bool {
-	evt, ok := events["goModeExit"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func() bool)
-	if !ok {
-		return false
-	}
-	fn()
-	return true
+	return dispatch("goModeExit", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func() bool)
+		if !ok {
+			return false, false
+		}
+		return fn(), true
+	})
 }
 
 //export onPlayerConnect
 func onPlayerConnect(playerid C.int) bool {
-	evt, ok := events["playerConnect"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)})
+	return dispatch("playerConnect", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}), true
+	})
 }
 
 //export onPlayerDisconnect
 func onPlayerDisconnect(playerid C.int, reason C.int) bool {
-	evt, ok := events["playerDisconnect"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, int(reason))
+	return dispatch("playerDisconnect", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(reason)), true
+	})
 }
 
 //export onPlayerSpawn
 func onPlayerSpawn(playerid C.int) bool {
-	evt, ok := events["playerSpawn"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(Player) bool)
-	if !ok {
-		return true
-	}
-	return fn(Player{ID: int(playerid)})
+	return dispatch("playerSpawn", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}), true
+	})
 }
 
 //export onPlayerDeath
 func onPlayerDeath(playerid C.int, killerid C.int, reason C.int) bool {
-	evt, ok := events["playerDeath"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, Player, int) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, Player{ID: int(killerid)}, int(reason))
+	return dispatch("playerDeath", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, Player, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, Player{ID: int(killerid)}, int(reason)), true
+	})
 }
 
 //export onVehicleSpawn
 func onVehicleSpawn(vehicleid C.int) bool {
-	evt, ok := events["vehicleSpawn"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(int) bool)
-	if !ok {
-		return true
-	}
-	return fn(int(vehicleid))
+	return dispatch("vehicleSpawn", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(int(vehicleid)), true
+	})
 }
 
 //export onVehicleDeath
 func onVehicleDeath(vehicleid C.int, killerid C.int) bool {
-	evt, ok := events["vehicleDeath"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(int, Player) bool)
-	if !ok {
-		return false
-	}
-	fn(int(vehicleid), Player{ID: int(killerid)})
-	return true
+	return dispatch("vehicleDeath", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(int, Player) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(int(vehicleid), Player{ID: int(killerid)}), true
+	})
 }
 
 //export onPlayerText
 func onPlayerText(playerid C.int, text *C.char_t) bool {
-	evt, ok := events["playerText"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(Player, string) bool)
-	if !ok {
-		return true
-	}
-	return fn(Player{ID: int(playerid)}, C.GoString(C.constToNonConst(text)))
+	return dispatch("playerText", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, string) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, C.GoString(C.constToNonConst(text))), true
+	})
 }
 
 //export onPlayerCommandText
 func onPlayerCommandText(playerid C.int, cmdtext *C.char_t) bool {
-	evt, ok := events["playerCommandText"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(Player, string) bool)
-	if !ok {
-		return true
-	}
-	return fn(Player{ID: int(playerid)}, C.GoString(C.constToNonConst(cmdtext)))
+	return dispatch("playerCommandText", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, string) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, C.GoString(C.constToNonConst(cmdtext))), true
+	})
 }
 
 //export onPlayerRequestClass
 func onPlayerRequestClass(playerid C.int, classid C.int) bool {
-	evt, ok := events["playerRequestClass"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)}, int(classid))
-	return true
+	return dispatch("playerRequestClass", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(classid)), true
+	})
 }
 
 //export onPlayerEnterVehicle
 func onPlayerEnterVehicle(playerid C.int, vehicleid C.int, ispassenger C.bool) bool {
-	evt, ok := events["playerEnterVehicle"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, bool) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)}, int(vehicleid), bool(ispassenger))
-	return true
+	return dispatch("playerEnterVehicle", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, bool) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(vehicleid), bool(ispassenger)), true
+	})
 }
 
 //export onPlayerExitVehicle
 func onPlayerExitVehicle(playerid C.int, vehicleid C.int) bool {
-	evt, ok := events["playerExitVehicle"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)}, int(vehicleid))
-	return true
+	return dispatch("playerExitVehicle", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(vehicleid)), true
+	})
 }
 
 //export onPlayerStateChange
 func onPlayerStateChange(playerid C.int, newstate C.int, oldstate C.int) bool {
-	evt, ok := events["playerStateChange"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, int) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)}, int(newstate), int(oldstate))
-	return true
+	return dispatch("playerStateChange", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(newstate), int(oldstate)), true
+	})
 }
 
 //export onPlayerEnterCheckpoint
 func onPlayerEnterCheckpoint(playerid C.int) bool {
-	evt, ok := events["playerEnterCheckpoint"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)})
-	return true
+	return dispatch("playerEnterCheckpoint", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}), true
+	})
 }
 
 //export onPlayerLeaveCheckpoint
 func onPlayerLeaveCheckpoint(playerid C.int) bool {
-	evt, ok := events["playerLeaveCheckpoint"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)})
-	return true
+	return dispatch("playerLeaveCheckpoint", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}), true
+	})
 }
 
 //export onPlayerEnterRaceCheckpoint
 func onPlayerEnterRaceCheckpoint(playerid C.int) bool {
-	evt, ok := events["playerEnterRaceCheckpoint"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)})
-	return true
+	return dispatch("playerEnterRaceCheckpoint", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}), true
+	})
 }
 
 //export onPlayerLeaveRaceCheckpoint
 func onPlayerLeaveRaceCheckpoint(playerid C.int) bool {
-	evt, ok := events["playerLeaveRaceCheckpoint"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)})
-	return true
+	return dispatch("playerLeaveRaceCheckpoint", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}), true
+	})
 }
 
 //export onRconCommand
 func onRconCommand(cmd *C.char_t) bool {
-	evt, ok := events["rconCommand"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(string) bool)
-	if !ok {
-		return false
-	}
-	return fn(C.GoString(C.constToNonConst(cmd)))
+	return dispatch("rconCommand", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(string) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(C.GoString(C.constToNonConst(cmd))), true
+	})
 }
 
 //export onPlayerRequestSpawn
 func onPlayerRequestSpawn(playerid C.int) bool {
-	evt, ok := events["playerRequestSpawn"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(Player) bool)
-	if !ok {
-		return true
-	}
-	return fn(Player{ID: int(playerid)})
+	return dispatch("playerRequestSpawn", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}), true
+	})
 }
 
 //export onObjectMoved
 func onObjectMoved(objectid C.int) bool {
-	evt, ok := events["objectMoved"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(int) bool)
-	if !ok {
-		return false
-	}
-	fn(int(objectid))
-	return true
+	return dispatch("objectMoved", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(int(objectid)), true
+	})
 }
 
 //export onPlayerObjectMoved
 func onPlayerObjectMoved(playerid C.int, objectid C.int) bool {
-	evt, ok := events["playerObjectMoved"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)}, int(objectid))
-	return true
+	return dispatch("playerObjectMoved", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(objectid)), true
+	})
 }
 
 //export onPlayerPickUpPickup
 func onPlayerPickUpPickup(playerid C.int, pickupid C.int) bool {
-	evt, ok := events["playerPickUpPickup"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)}, int(pickupid))
-	return true
+	return dispatch("playerPickUpPickup", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(pickupid)), true
+	})
 }
 
 //export onVehicleMod
 func onVehicleMod(playerid C.int, vehicleid C.int, componentid C.int) bool {
-	evt, ok := events["vehicleMod"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, int) bool)
-	if !ok {
-		return true
-	}
-	return fn(Player{ID: int(playerid)}, int(vehicleid), int(componentid))
+	return dispatch("vehicleMod", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(vehicleid), int(componentid)), true
+	})
 }
 
 //export onEnterExitModShop
 func onEnterExitModShop(playerid C.int, enterexit C.bool, interiorid C.int) bool {
-	evt, ok := events["enterExitModShop"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, bool, int) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)}, bool(enterexit), int(interiorid))
-	return true
+	return dispatch("enterExitModShop", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, bool, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, bool(enterexit), int(interiorid)), true
+	})
 }
 
 //export onVehiclePaintjob
 func onVehiclePaintjob(playerid C.int, vehicleid C.int, paintjobid C.int) bool {
-	evt, ok := events["vehiclePaintjob"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, int) bool)
-	if !ok {
-		return true
-	}
-	return fn(Player{ID: int(playerid)}, int(vehicleid), int(paintjobid))
+	return dispatch("vehiclePaintjob", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(vehicleid), int(paintjobid)), true
+	})
 }
 
 //export onVehicleRespray
 func onVehicleRespray(playerid C.int, vehicleid C.int, color1 C.int, color2 C.int) bool {
-	evt, ok := events["vehicleRespray"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, int, int) bool)
-	if !ok {
-		return true
-	}
-	return fn(Player{ID: int(playerid)}, int(vehicleid), int(color1), int(color2))
+	return dispatch("vehicleRespray", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(vehicleid), int(color1), int(color2)), true
+	})
 }
 
 //export onVehicleDamageStatusUpdate
 func onVehicleDamageStatusUpdate(vehicleid C.int, playerid C.int) bool {
-	evt, ok := events["vehicleDamageStatusUpdate"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(int, Player) bool)
-	if !ok {
-		return false
-	}
-	return fn(int(vehicleid), Player{ID: int(playerid)})
+	return dispatch("vehicleDamageStatusUpdate", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(int, Player) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(int(vehicleid), Player{ID: int(playerid)}), true
+	})
 }
 
 //export onUnoccupiedVehicleUpdate
 func onUnoccupiedVehicleUpdate(vehicleid C.int, playerid C.int, passenger_seat C.int, new_x C.float, new_y C.float, new_z C.float, vel_x C.float, vel_y C.float, vel_z C.float) bool {
-	evt, ok := events["unoccupiedVehicleUpdate"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(int, Player, int, float32, float32, float32, float32, float32, float32) bool)
-	if !ok {
-		return true
-	}
-	return fn(int(vehicleid), Player{ID: int(playerid)}, int(passenger_seat), float32(new_x), float32(new_y), float32(new_z), float32(vel_x), float32(vel_y), float32(vel_z))
+	return dispatch("unoccupiedVehicleUpdate", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(int, Player, int, float32, float32, float32, float32, float32, float32) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(int(vehicleid), Player{ID: int(playerid)}, int(passenger_seat), float32(new_x), float32(new_y), float32(new_z), float32(vel_x), float32(vel_y), float32(vel_z)), true
+	})
 }
 
 //export onPlayerSelectedMenuRow
 func onPlayerSelectedMenuRow(playerid C.int, row C.int) bool {
-	evt, ok := events["playerSelectedMenuRow"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)}, int(row))
-	return true
+	return dispatch("playerSelectedMenuRow", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(row)), true
+	})
 }
 
 //export onPlayerExitedMenu
 func onPlayerExitedMenu(playerid C.int) bool {
-	evt, ok := events["playerExitedMenu"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)})
-	return true
+	return dispatch("playerExitedMenu", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}), true
+	})
 }
 
 //export onPlayerInteriorChange
 func onPlayerInteriorChange(playerid C.int, newinteriorid C.int, oldinteriorid C.int) bool {
-	evt, ok := events["playerInteriorChange"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, int) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)}, int(newinteriorid), int(oldinteriorid))
-	return true
+	return dispatch("playerInteriorChange", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(newinteriorid), int(oldinteriorid)), true
+	})
 }
 
 //export onPlayerKeyStateChange
 func onPlayerKeyStateChange(playerid C.int, newkeys C.int, oldkeys C.int) bool {
-	evt, ok := events["playerKeyStateChange"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, int) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)}, int(newkeys), int(oldkeys))
-	return true
+	return dispatch("playerKeyStateChange", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(newkeys), int(oldkeys)), true
+	})
 }
 
 //export onRconLoginAttempt
 func onRconLoginAttempt(ip *C.char_t, password *C.char_t, success C.bool) bool {
-	evt, ok := events["rconLoginAttempt"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(string, string, bool) bool)
-	if !ok {
-		return false
-	}
-	fn(C.GoString(C.constToNonConst(ip)), C.GoString(C.constToNonConst(password)), bool(success))
-	return true
+	return dispatch("rconLoginAttempt", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(string, string, bool) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(C.GoString(C.constToNonConst(ip)), C.GoString(C.constToNonConst(password)), bool(success)), true
+	})
 }
 
 //export onPlayerUpdate
 func onPlayerUpdate(playerid C.int) bool {
-	evt, ok := events["playerUpdate"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(Player) bool)
-	if !ok {
-		return true
-	}
-	return fn(Player{ID: int(playerid)})
+	return dispatch("playerUpdate", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}), true
+	})
 }
 
 //export onPlayerStreamIn
 func onPlayerStreamIn(playerid C.int, forplayerid C.int) bool {
-	evt, ok := events["playerStreamIn"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)}, int(forplayerid))
-	return true
+	return dispatch("playerStreamIn", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(forplayerid)), true
+	})
 }
 
 //export onPlayerStreamOut
 func onPlayerStreamOut(playerid C.int, forplayerid C.int) bool {
-	evt, ok := events["playerStreamOut"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int) bool)
-	if !ok {
-		return false
-	}
-	fn(Player{ID: int(playerid)}, int(forplayerid))
-	return true
+	return dispatch("playerStreamOut", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(forplayerid)), true
+	})
 }
 
 //export onVehicleStreamIn
 func onVehicleStreamIn(vehicleid C.int, forplayerid C.int) bool {
-	evt, ok := events["vehicleStreamIn"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(int, int) bool)
-	if !ok {
-		return false
-	}
-	fn(int(vehicleid), int(forplayerid))
-	return true
+	return dispatch("vehicleStreamIn", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(int(vehicleid), int(forplayerid)), true
+	})
 }
 
 //export onVehicleStreamOut
 func onVehicleStreamOut(vehicleid C.int, forplayerid C.int) bool {
-	evt, ok := events["vehicleStreamOut"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(int, int) bool)
-	if !ok {
-		return false
-	}
-	fn(int(vehicleid), int(forplayerid))
-	return true
+	return dispatch("vehicleStreamOut", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(int(vehicleid), int(forplayerid)), true
+	})
 }
 
 //export onActorStreamIn
 func onActorStreamIn(actorid C.int, forplayerid C.int) bool {
-	evt, ok := events["actorStreamIn"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(int, int) bool)
-	if !ok {
-		return false
-	}
-	fn(int(actorid), int(forplayerid))
-	return true
+	return dispatch("actorStreamIn", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(int(actorid), int(forplayerid)), true
+	})
 }
 
 //export onActorStreamOut
 func onActorStreamOut(actorid C.int, forplayerid C.int) bool {
-	evt, ok := events["actorStreamOut"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(int, int) bool)
-	if !ok {
-		return false
-	}
-	fn(int(actorid), int(forplayerid))
-	return true
+	return dispatch("actorStreamOut", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(int(actorid), int(forplayerid)), true
+	})
 }
 
 //export onDialogResponse
 func onDialogResponse(playerid C.int, dialogid C.int, response C.int, listitem C.int, inputtext *C.char_t) bool {
-	evt, ok := events["dialogResponse"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, int, int, string) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, int(dialogid), int(response), int(listitem), C.GoString(C.constToNonConst(inputtext)))
+	return dispatch("dialogResponse", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, int, int, string) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(dialogid), int(response), int(listitem), C.GoString(C.constToNonConst(inputtext))), true
+	})
 }
 
 //export onPlayerTakeDamage
 func onPlayerTakeDamage(playerid C.int, issuerid C.int, amount C.float, weaponid C.int, bodypart C.int) bool {
-	evt, ok := events["playerTakeDamage"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, Player, float32, int, int) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, Player{ID: int(issuerid)}, float32(amount), int(weaponid), int(bodypart))
+	return dispatch("playerTakeDamage", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, Player, float32, int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, Player{ID: int(issuerid)}, float32(amount), int(weaponid), int(bodypart)), true
+	})
 }
 
 //export onPlayerGiveDamage
 func onPlayerGiveDamage(playerid C.int, damagedid C.int, amount C.float, weaponid C.int, bodypart C.int) bool {
-	evt, ok := events["playerGiveDamage"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, float32, int, int) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, int(damagedid), float32(amount), int(weaponid), int(bodypart))
+	return dispatch("playerGiveDamage", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, float32, int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(damagedid), float32(amount), int(weaponid), int(bodypart)), true
+	})
 }
 
 //export onPlayerGiveDamageActor
 func onPlayerGiveDamageActor(playerid C.int, damaged_actorid C.int, amount C.float, weaponid C.int, bodypart C.int) bool {
-	evt, ok := events["playerGiveDamageActor"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, float32, int, int) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, int(damaged_actorid), float32(amount), int(weaponid), int(bodypart))
+	return dispatch("playerGiveDamageActor", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, float32, int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(damaged_actorid), float32(amount), int(weaponid), int(bodypart)), true
+	})
 }
 
 //export onPlayerClickMap
 func onPlayerClickMap(playerid C.int, fX C.float, fY C.float, fZ C.float) bool {
-	evt, ok := events["playerClickMap"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, float32, float32, float32) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, float32(fX), float32(fY), float32(fZ))
+	return dispatch("playerClickMap", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, float32, float32, float32) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, float32(fX), float32(fY), float32(fZ)), true
+	})
 }
 
 //export onPlayerClickTextDraw
 func onPlayerClickTextDraw(playerid C.int, clickedid C.int) bool {
-	evt, ok := events["playerClickTextDraw"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, int(clickedid))
+	return dispatch("playerClickTextDraw", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(clickedid)), true
+	})
 }
 
 //export onPlayerClickPlayerTextDraw
 func onPlayerClickPlayerTextDraw(playerid C.int, playertextid C.int) bool {
-	evt, ok := events["playerClickPlayerTextDraw"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, int(playertextid))
+	return dispatch("playerClickPlayerTextDraw", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(playertextid)), true
+	})
 }
 
 //export onIncomingConnection
 func onIncomingConnection(playerid C.int, ip_address *C.char_t, port C.int) bool {
-	evt, ok := events["incomingConnection"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, string, int) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, C.GoString(C.constToNonConst(ip_address)), int(port))
+	return dispatch("incomingConnection", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, string, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, C.GoString(C.constToNonConst(ip_address)), int(port)), true
+	})
 }
 
 //export onTrailerUpdate
 func onTrailerUpdate(playerid C.int, vehicleid C.int) bool {
-	evt, ok := events["trailerUpdate"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(Player, int) bool)
-	if !ok {
-		return true
-	}
-	return fn(Player{ID: int(playerid)}, int(vehicleid))
+	return dispatch("trailerUpdate", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(vehicleid)), true
+	})
 }
 
 //export onVehicleSirenStateChange
 func onVehicleSirenStateChange(playerid C.int, vehicleid C.int, newstate C.int) bool {
-	evt, ok := events["vehicleSirenStateChange"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, int) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, int(vehicleid), int(newstate))
+	return dispatch("vehicleSirenStateChange", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(vehicleid), int(newstate)), true
+	})
 }
 
 //export onPlayerClickPlayer
 func onPlayerClickPlayer(playerid C.int, clickedplayerid C.int, source C.int) bool {
-	evt, ok := events["playerClickPlayer"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, int) bool)
-	if !ok {
-		return true
-	}
-	fn(Player{ID: int(playerid)}, int(clickedplayerid), int(source))
-	return false
+	return dispatch("playerClickPlayer", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(clickedplayerid), int(source)), true
+	})
 }
 
 //export onPlayerEditObject
 func onPlayerEditObject(playerid C.int, playerobject C.bool, objectid C.int, response C.int, fX C.float, fY C.float, fZ C.float, fRotX C.float, fRotY C.float, fRotZ C.float) bool {
-	evt, ok := events["playerEditObject"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, bool, int, int, float32, float32, float32, float32, float32, float32) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, bool(playerobject), int(objectid), int(response), float32(fX), float32(fY), float32(fZ), float32(fRotX), float32(fRotY), float32(fRotZ))
+	return dispatch("playerEditObject", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, bool, int, int, float32, float32, float32, float32, float32, float32) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, bool(playerobject), int(objectid), int(response), float32(fX), float32(fY), float32(fZ), float32(fRotX), float32(fRotY), float32(fRotZ)), true
+	})
 }
 
 //export onPlayerEditAttachedObject
 func onPlayerEditAttachedObject(playerid C.int, response C.int, index C.int, modelid C.int, boneid C.int, fOffsetX C.float, fOffsetY C.float, fOffsetZ C.float, fRotX C.float, fRotY C.float, fRotZ C.float, fScaleX C.float, fScaleY C.float, fScaleZ C.float) bool {
-	evt, ok := events["playerEditAttachedObject"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, int, int, int, float32, float32, float32, float32, float32, float32, float32, float32, float32) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, int(response), int(index), int(modelid), int(boneid), float32(fOffsetX), float32(fOffsetY), float32(fOffsetZ), float32(fRotX), float32(fRotY), float32(fRotZ), float32(fScaleX), float32(fScaleY), float32(fScaleZ))
+	return dispatch("playerEditAttachedObject", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, int, int, int, float32, float32, float32, float32, float32, float32, float32, float32, float32) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(response), int(index), int(modelid), int(boneid), float32(fOffsetX), float32(fOffsetY), float32(fOffsetZ), float32(fRotX), float32(fRotY), float32(fRotZ), float32(fScaleX), float32(fScaleY), float32(fScaleZ)), true
+	})
 }
 
 //export onPlayerSelectObject
 func onPlayerSelectObject(playerid C.int, type_ C.int, objectid C.int, modelid C.int, fX C.float, fY C.float, fZ C.float) bool {
-	evt, ok := events["playerSelectObject"]
-	if !ok {
-		return false
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, int, int, float32, float32, float32) bool)
-	if !ok {
-		return false
-	}
-	return fn(Player{ID: int(playerid)}, int(type_), int(objectid), int(modelid), float32(fX), float32(fY), float32(fZ))
+	return dispatch("playerSelectObject", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, int, int, float32, float32, float32) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(type_), int(objectid), int(modelid), float32(fX), float32(fY), float32(fZ)), true
+	})
 }
 
 //export onPlayerWeaponShot
 func onPlayerWeaponShot(playerid C.int, weaponid C.int, hittype C.int, hitid C.int, fX C.float, fY C.float, fZ C.float) bool {
-	evt, ok := events["playerWeaponShot"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, int, int, float32, float32, float32) bool)
-	if !ok {
-		return true
-	}
-	return fn(Player{ID: int(playerid)}, int(weaponid), int(hittype), int(hitid), float32(fX), float32(fY), float32(fZ))
+	return dispatch("playerWeaponShot", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, int, int, float32, float32, float32) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(weaponid), int(hittype), int(hitid), float32(fX), float32(fY), float32(fZ)), true
+	})
 }
 
 //export onPlayerRequestDownload
 func onPlayerRequestDownload(playerid C.int, type_ C.int, crc C.int) bool {
-	evt, ok := events["playerRequestDownload"]
-	if !ok {
-		return true
-	}
-
-	fn, ok := evt.Handler.(func(Player, int, int) bool)
-	if !ok {
-		return true
-	}
-	return fn(Player{ID: int(playerid)}, int(type_), int(crc))
+	return dispatch("playerRequestDownload", true, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(Player, int, int) bool)
+		if !ok {
+			return false, false
+		}
+		return fn(Player{ID: int(playerid)}, int(type_), int(crc)), true
+	})
 }
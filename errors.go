@@ -0,0 +1,71 @@
+package sampgo
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by the Player, Vehicle, Actor, Object, textdraw and GangZone
+// methods, wrapped in an *EntityError carrying the ID of the entity. Check for
+// them with errors.Is.
+var (
+	ErrPlayerNotConnected    = errors.New("player is not connected")
+	ErrNotInVehicle          = errors.New("player is not in a vehicle")
+	ErrNameInUse             = errors.New("name is already in use")
+	ErrSameName              = errors.New("player already has that name")
+	ErrInvalidName           = errors.New("invalid name")
+	ErrPVarNotSet            = errors.New("player variable is not set")
+	ErrInvalidMessage        = errors.New("message must be between 1 and 144 characters")
+	ErrInvalidVehicle        = errors.New("vehicle does not exist")
+	ErrInvalidVehicleModel   = errors.New("invalid vehicle model")
+	ErrInvalidComponent      = errors.New("invalid vehicle component")
+	ErrInvalidSeat           = errors.New("invalid vehicle seat")
+	ErrInvalidObject         = errors.New("object does not exist")
+	ErrInvalidActor          = errors.New("actor does not exist")
+	ErrInvalidSkin           = errors.New("invalid skin")
+	ErrInvalidTextDraw       = errors.New("textdraw does not exist")
+	ErrInvalidGangZone       = errors.New("gang zone does not exist")
+	ErrInvalidTextDrawString = errors.New("textdraw text must be between 1 and 1024 characters")
+	ErrLimitReached          = errors.New("limit reached")
+)
+
+// EntityError is an error concerning a single player, vehicle, actor, object,
+// textdraw or gang zone. Retrieve it with errors.As to get the ID.
+type EntityError struct {
+	// Entity is the kind of entity, such as "player" or "vehicle".
+	Entity string
+	ID     int
+	Err    error
+}
+
+func (e *EntityError) Error() string {
+	return fmt.Sprintf("%s %d: %v", e.Entity, e.ID, e.Err)
+}
+
+func (e *EntityError) Unwrap() error {
+	return e.Err
+}
+
+func playerError(id int, err error) error {
+	return &EntityError{Entity: "player", ID: id, Err: err}
+}
+
+func vehicleError(id int, err error) error {
+	return &EntityError{Entity: "vehicle", ID: id, Err: err}
+}
+
+func actorError(id int, err error) error {
+	return &EntityError{Entity: "actor", ID: id, Err: err}
+}
+
+func objectError(id int, err error) error {
+	return &EntityError{Entity: "object", ID: id, Err: err}
+}
+
+func textDrawError(id int, err error) error {
+	return &EntityError{Entity: "textdraw", ID: id, Err: err}
+}
+
+func gangZoneError(id int, err error) error {
+	return &EntityError{Entity: "gang zone", ID: id, Err: err}
+}
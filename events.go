@@ -0,0 +1,364 @@
+package sampgo
+
+import (
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"sort"
+	"strings"
+)
+
+type EventType int
+
+const (
+	Repeat EventType = iota
+	OnceOnly
+)
+
+// Priority decides the order in which handlers of the same event are called.
+// Handlers with a higher priority are called first, handlers with the same
+// priority are called in the order they were registered.
+type Priority int
+
+const (
+	PriorityLowest  Priority = -200
+	PriorityLow     Priority = -100
+	PriorityNormal  Priority = 0
+	PriorityHigh    Priority = 100
+	PriorityHighest Priority = 200
+)
+
+// ReturnPolicy decides how the bool results of several handlers registered
+// for the same event are combined into the value returned to the server.
+type ReturnPolicy int
+
+const (
+	// ReturnLast calls every handler and returns the result of the last one.
+	ReturnLast ReturnPolicy = iota
+	// StopOnFalse stops at the first handler returning false and returns false.
+	StopOnFalse
+	// StopOnTrue stops at the first handler returning true and returns true.
+	StopOnTrue
+)
+
+// ErrorPolicy decides what happens when a handler panics or returns an error.
+// It applies to timers, functions queued with RunOnMainThread and natives
+// panicking as well.
+type ErrorPolicy int
+
+const (
+	// LogAndContinue logs the failure and keeps the handler registered.
+	LogAndContinue ErrorPolicy = iota
+	// DisableHandler logs the failure and removes the handler once it has
+	// failed as many times as set with SetMaxHandlerFailures. Timers are
+	// cancelled and natives return 0 without being called.
+	DisableHandler
+	// ExitGameMode logs the failure and ends the game mode with GameModeExit.
+	ExitGameMode
+)
+
+type event struct {
+	Handler  interface{}
+	Type     EventType
+	Priority Priority
+	removed  bool
+	failures int
+}
+
+// Subscription is a handle to a registered event handler.
+type Subscription struct {
+	eventName string
+	evt       *event
+}
+
+var events = make(map[string][]*event)
+
+// Prefixes of the keys holding the handlers scoped to a single public or
+// entity in the events map, so they never clash with an event of the same
+// name.
+const (
+	publicPrefix   = "public:"
+	actorPrefix    = "actor:"
+	textDrawPrefix = "textdraw:"
+)
+
+var policies = map[string]ReturnPolicy{
+	"playerText":        StopOnFalse,
+	"playerCommandText": StopOnFalse,
+}
+
+var errorPolicy = LogAndContinue
+var maxHandlerFailures = 3
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+var customSignatures = []reflect.Type{
+	reflect.TypeOf((func())(nil)),
+	reflect.TypeOf((func([]interface{}))(nil)),
+	reflect.TypeOf((func() interface{})(nil)),
+	reflect.TypeOf((func([]interface{}) interface{})(nil)),
+}
+
+// On registers an event with a handler.
+func On(eventName string, handler interface{}) (*Subscription, error) {
+	return register(eventName, handler, Repeat, PriorityNormal)
+}
+
+// OnWithPriority registers an event with a handler that is called before all
+// handlers of a lower priority.
+func OnWithPriority(eventName string, priority Priority, handler interface{}) (*Subscription, error) {
+	return register(eventName, handler, Repeat, priority)
+}
+
+// Once registers an event with a handler one time only.
+// The handler is removed after it has been called for the first time.
+func Once(eventName string, handler interface{}) (*Subscription, error) {
+	return register(eventName, handler, OnceOnly, PriorityNormal)
+}
+
+// OnceWithPriority registers an event with a handler one time only, called
+// before all handlers of a lower priority.
+func OnceWithPriority(eventName string, priority Priority, handler interface{}) (*Subscription, error) {
+	return register(eventName, handler, OnceOnly, priority)
+}
+
+// Off removes the handler from its event. Calling Off more than once,
+// or on a Once handler that has already been called, does nothing.
+func (s *Subscription) Off() {
+	if s.evt.removed {
+		return
+	}
+	s.evt.removed = true
+
+	handlers := events[s.eventName]
+	for i, evt := range handlers {
+		if evt != s.evt {
+			continue
+		}
+
+		if len(handlers) == 1 {
+			delete(events, s.eventName)
+			unhookPublic(s.eventName)
+			return
+		}
+		// Build a new slice so a dispatch iterating the old one is not disturbed.
+		events[s.eventName] = append(handlers[:i:i], handlers[i+1:]...)
+		return
+	}
+}
+
+// removeEvent removes every handler of an event, such as the handlers of a
+// destroyed actor.
+func removeEvent(eventName string) {
+	for _, evt := range events[eventName] {
+		evt.removed = true
+	}
+	delete(events, eventName)
+}
+
+// Unsubscribe is an alias of Off.
+func (s *Subscription) Unsubscribe() {
+	s.Off()
+}
+
+// SetErrorPolicy sets what happens when a handler panics or returns an error.
+// Failures are always logged, the default policy is LogAndContinue.
+func SetErrorPolicy(policy ErrorPolicy) {
+	errorPolicy = policy
+}
+
+// SetMaxHandlerFailures sets after how many failures a handler is removed
+// under the DisableHandler policy. The default is 3.
+func SetMaxHandlerFailures(n int) {
+	if n < 1 {
+		n = 1
+	}
+	maxHandlerFailures = n
+}
+
+// SetReturnPolicy sets how the results of an event's handlers are combined.
+// playerText and playerCommandText default to StopOnFalse, every other event
+// defaults to ReturnLast.
+func SetReturnPolicy(eventName string, policy ReturnPolicy) {
+	policies[eventName] = policy
+}
+
+func register(eventName string, handler interface{}, typ EventType, priority Priority) (*Subscription, error) {
+	want, err := validateHandler(eventName, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	evt := &event{Handler: handler, Type: typ, Priority: priority}
+	if reflect.TypeOf(handler) != want {
+		evt.Handler = wrapErrorHandler(eventName, evt, want, handler)
+	}
+
+	return insert(eventName, evt), nil
+}
+
+// insert adds evt to the handlers of eventName.
+func insert(eventName string, evt *event) *Subscription {
+	handlers := events[eventName]
+	// Keep the slice sorted by descending priority, after any handler of equal priority.
+	i := sort.Search(len(handlers), func(i int) bool {
+		return handlers[i].Priority < evt.Priority
+	})
+
+	updated := make([]*event, 0, len(handlers)+1)
+	updated = append(updated, handlers[:i]...)
+	updated = append(updated, evt)
+	updated = append(updated, handlers[i:]...)
+	events[eventName] = updated
+
+	logger.Debug("Registered event", "event", eventName)
+
+	return &Subscription{eventName: eventName, evt: evt}
+}
+
+// validateHandler checks that handler can be called for eventName and returns
+// the signature it is dispatched with. Built-in events require the signature
+// listed in eventSignatures, any other name is a custom event called from Pawn
+// and takes func() or func([]interface{}), optionally returning a value passed
+// back to Pawn. In both cases the handler may return an error in place of its
+// result.
+func validateHandler(eventName string, handler interface{}) (reflect.Type, error) {
+	t := reflect.TypeOf(handler)
+	if t == nil || t.Kind() != reflect.Func {
+		return nil, fmt.Errorf("handler for %s event must be a function, got %T", eventName, handler)
+	}
+	if reflect.ValueOf(handler).IsNil() {
+		return nil, fmt.Errorf("handler for %s event is nil", eventName)
+	}
+
+	if want, ok := eventSignatures[eventName]; ok {
+		if t != want && t != errorSignature(want) {
+			return nil, fmt.Errorf("handler for %s event must be %s or %s, got %s", eventName, want, errorSignature(want), t)
+		}
+		return want, nil
+	}
+
+	for name := range eventSignatures {
+		if strings.EqualFold(name, eventName) {
+			return nil, fmt.Errorf("unknown event %s, did you mean %s?", eventName, name)
+		}
+	}
+
+	for _, want := range customSignatures {
+		if t == want || t == errorSignature(want) {
+			return want, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown event %s: custom event handlers must be func() or func([]interface{}) with an optional interface{} result, got %s", eventName, t)
+}
+
+// errorSignature returns sig with its result replaced by a single error.
+func errorSignature(sig reflect.Type) reflect.Type {
+	in := make([]reflect.Type, sig.NumIn())
+	for i := range in {
+		in[i] = sig.In(i)
+	}
+	return reflect.FuncOf(in, []reflect.Type{errorType}, false)
+}
+
+// wrapErrorHandler turns a handler returning an error into one of signature
+// want. A returned error is reported through the error policy and counts as a
+// false result.
+func wrapErrorHandler(eventName string, evt *event, want reflect.Type, handler interface{}) interface{} {
+	fn := reflect.ValueOf(handler)
+
+	return reflect.MakeFunc(want, func(args []reflect.Value) []reflect.Value {
+		err, _ := fn.Call(args)[0].Interface().(error)
+		if err != nil {
+			handlerFailed(eventName, evt, err, nil)
+		}
+
+		if want.NumOut() == 0 {
+			return nil
+		}
+		return []reflect.Value{reflect.ValueOf(err == nil)}
+	}).Interface()
+}
+
+// handlerFailed logs a failed handler and applies the error policy.
+func handlerFailed(eventName string, evt *event, err error, stack []byte) {
+	evt.failures++
+	ReportFailure("Event handler", evt.failures, func() {
+		(&Subscription{eventName: eventName, evt: evt}).Off()
+	}, err, stack, "event", eventName)
+}
+
+// ReportFailure logs the failure of a function run on the server thread and
+// applies the error policy, for event handlers as well as timers, functions
+// queued with RunOnMainThread and natives. what names the kind of function,
+// such as "Timer", failures is how often it has failed so far and disable
+// stops it from being called again under the DisableHandler policy, nil if it
+// can not be disabled. keyvals are added to the log entry.
+func ReportFailure(what string, failures int, disable func(), err error, stack []byte, keyvals ...interface{}) {
+	fields := append([]interface{}(nil), keyvals...)
+	fields = append(fields, "error", err)
+	if stack != nil {
+		fields = append(fields, "stack", string(stack))
+	}
+	logger.Error(what+" failed", fields...)
+
+	switch errorPolicy {
+	case DisableHandler:
+		if disable != nil && failures >= maxHandlerFailures {
+			disable()
+			logger.Warn("Disabled "+strings.ToLower(what), append(fields[:len(keyvals):len(keyvals)], "failures", failures)...)
+		}
+	case ExitGameMode:
+		logger.Error("Exiting game mode because of the failed "+strings.ToLower(what), keyvals...)
+		GameModeExit()
+	}
+}
+
+// call invokes a single handler, recovering from any panic it raises. A Once
+// handler that panicked counts as called and is removed.
+func (evt *event) call(eventName string, call func(handler interface{}) (bool, bool)) (ret bool, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			handlerFailed(eventName, evt, fmt.Errorf("panic: %v", r), debug.Stack())
+			if evt.Type == OnceOnly {
+				(&Subscription{eventName: eventName, evt: evt}).Off()
+			}
+			ret, ok = false, false
+		}
+	}()
+
+	return call(evt.Handler)
+}
+
+// dispatch calls the handlers of an event in priority order and combines their
+// results according to the event's ReturnPolicy. call type asserts and invokes
+// a single handler, reporting ok as false if the handler has another signature.
+// def is returned if no handler could be called. A panicking handler is
+// recovered and its result ignored like that of a handler of another
+// signature.
+func dispatch(eventName string, def bool, call func(handler interface{}) (ret bool, ok bool)) bool {
+	policy := policies[eventName]
+	ret := def
+
+	for _, evt := range events[eventName] {
+		if evt.removed {
+			continue
+		}
+
+		r, ok := evt.call(eventName, call)
+		if !ok {
+			continue
+		}
+		ret = r
+
+		if evt.Type == OnceOnly {
+			(&Subscription{eventName: eventName, evt: evt}).Off()
+		}
+
+		if (policy == StopOnFalse && !r) || (policy == StopOnTrue && r) {
+			break
+		}
+	}
+
+	return ret
+}
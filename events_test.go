@@ -0,0 +1,142 @@
+package sampgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testDispatch dispatches eventName to handlers of type func() bool.
+func testDispatch(eventName string) bool {
+	return dispatch(eventName, false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func() bool)
+		if !ok {
+			return false, false
+		}
+		return fn(), true
+	})
+}
+
+func TestDispatchOrder(t *testing.T) {
+	const eventName = "testDispatchOrder"
+	defer delete(events, eventName)
+
+	var calls []string
+	add := func(name string, priority Priority) {
+		insert(eventName, &event{Handler: func() bool {
+			calls = append(calls, name)
+			return true
+		}, Type: Repeat, Priority: priority})
+	}
+	add("normal 1", PriorityNormal)
+	add("lowest", PriorityLowest)
+	add("highest", PriorityHighest)
+	add("normal 2", PriorityNormal)
+	add("high", PriorityHigh)
+
+	testDispatch(eventName)
+
+	want := []string{"highest", "high", "normal 1", "normal 2", "lowest"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("handlers called in order %v, want %v", calls, want)
+	}
+}
+
+func TestDispatchReturnPolicy(t *testing.T) {
+	const eventName = "testDispatchReturnPolicy"
+	defer delete(events, eventName)
+	defer delete(policies, eventName)
+
+	tests := []struct {
+		policy  ReturnPolicy
+		results []bool
+		want    bool
+		called  int
+	}{
+		{ReturnLast, nil, false, 0},
+		{ReturnLast, []bool{true, false}, false, 2},
+		{ReturnLast, []bool{false, true}, true, 2},
+		{StopOnFalse, []bool{true, true}, true, 2},
+		{StopOnFalse, []bool{true, false, true}, false, 2},
+		{StopOnTrue, []bool{false, false}, false, 2},
+		{StopOnTrue, []bool{false, true, false}, true, 2},
+	}
+
+	for _, tt := range tests {
+		delete(events, eventName)
+		policies[eventName] = tt.policy
+
+		called := 0
+		for _, result := range tt.results {
+			result := result
+			insert(eventName, &event{Handler: func() bool {
+				called++
+				return result
+			}, Type: Repeat, Priority: PriorityNormal})
+		}
+
+		if got := testDispatch(eventName); got != tt.want {
+			t.Errorf("policy %d with results %v returned %v, want %v", tt.policy, tt.results, got, tt.want)
+		}
+		if called != tt.called {
+			t.Errorf("policy %d with results %v called %d handlers, want %d", tt.policy, tt.results, called, tt.called)
+		}
+	}
+}
+
+func TestDispatchOff(t *testing.T) {
+	const eventName = "testDispatchOff"
+	defer delete(events, eventName)
+
+	var calls []string
+	var self, later *Subscription
+	self = insert(eventName, &event{Handler: func() bool {
+		calls = append(calls, "self")
+		self.Off()
+		return true
+	}, Type: Repeat, Priority: PriorityHigh})
+	insert(eventName, &event{Handler: func() bool {
+		calls = append(calls, "offLater")
+		later.Off()
+		return true
+	}, Type: Repeat, Priority: PriorityNormal})
+	later = insert(eventName, &event{Handler: func() bool {
+		calls = append(calls, "later")
+		return true
+	}, Type: Repeat, Priority: PriorityLow})
+	insert(eventName, &event{Handler: func() bool {
+		calls = append(calls, "once")
+		return true
+	}, Type: OnceOnly, Priority: PriorityLowest})
+
+	testDispatch(eventName)
+	testDispatch(eventName)
+
+	want := []string{"self", "offLater", "once", "offLater"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("handlers called %v, want %v", calls, want)
+	}
+	if n := len(events[eventName]); n != 1 {
+		t.Errorf("%d handlers left, want 1", n)
+	}
+}
+
+func TestDispatchOncePanic(t *testing.T) {
+	const eventName = "testDispatchOncePanic"
+	defer delete(events, eventName)
+
+	called := 0
+	insert(eventName, &event{Handler: func() bool {
+		called++
+		panic("once")
+	}, Type: OnceOnly, Priority: PriorityNormal})
+
+	testDispatch(eventName)
+	testDispatch(eventName)
+
+	if called != 1 {
+		t.Errorf("panicking Once handler called %d times, want 1", called)
+	}
+	if _, ok := events[eventName]; ok {
+		t.Errorf("panicking Once handler was not removed")
+	}
+}
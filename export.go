@@ -0,0 +1,147 @@
+package sampgo
+
+/*
+#cgo windows CFLAGS: -I./lib -I./lib/amx -Wno-attributes -Wno-implicit-function-declaration
+#cgo windows CFLAGS: -DHAVE_INTTYPES_H -DHAVE_MALLOC_H -DHAVE_STDINT_H -DWIN32
+#cgo windows LDFLAGS: -Wl,--subsystem,windows,--kill-at
+
+#cgo linux CFLAGS: -I./lib -I./lib/amx -Wno-attributes -Wno-implicit-function-declaration
+#cgo linux CFLAGS: -DHAVE_INTTYPES_H -DHAVE_MALLOC_H -DHAVE_STDINT_H -DLINUX -D_GNU_SOURCE
+#cgo linux LDFLAGS: -ldl
+
+#ifndef GOLANG_APP
+#define GOLANG_APP
+
+#include "main.h"
+
+#endif
+*/
+import "C"
+import (
+	"fmt"
+	"io/ioutil"
+	"runtime/debug"
+	"strings"
+	"unsafe"
+)
+
+// NativeFunc implements a Pawn native in Go. params holds the arguments as
+// passed by the script: values for plain arguments, addresses for strings,
+// arrays and references. Use the AMX's String, SetString and Ref methods to
+// access the latter.
+type NativeFunc func(amx *AMX, params []Cell) Cell
+
+type goNative struct {
+	name   string
+	cname  *C.char
+	fn     NativeFunc
+	params []string
+	// failures and disabled apply the error policy to a panicking native.
+	failures int
+	disabled bool
+}
+
+var goNatives []goNative
+
+// nativeList is the NULL terminated AMX_NATIVE_INFO array passed to amx_Register.
+var nativeList *C.AMX_NATIVE_INFO
+
+// RegisterNative makes fn callable from Pawn as the native name. params are
+// the Pawn declarations of its parameters, such as "playerid" or
+// "const name[]", used by WriteNativeInclude.
+//
+// Natives are registered with every script as it is loaded, so RegisterNative
+// should be called from an init function, before the server loads any script.
+func RegisterNative(name string, fn NativeFunc, params ...string) error {
+	if len(name) == 0 || len(name) > 31 {
+		return fmt.Errorf("native name %q must be between 1 and 31 characters", name)
+	}
+	if fn == nil {
+		return fmt.Errorf("native %s has no function", name)
+	}
+	if len(goNatives) >= C.SAMPGO_MAX_NATIVES {
+		return fmt.Errorf("can not register more than %d natives", C.SAMPGO_MAX_NATIVES)
+	}
+	for _, n := range goNatives {
+		if n.name == name {
+			return fmt.Errorf("native %s is already registered", name)
+		}
+	}
+
+	goNatives = append(goNatives, goNative{name: name, cname: C.CString(name), fn: fn, params: params})
+	buildNativeList()
+
+	// Scripts that are already loaded only pick up natives they still miss.
+	for _, script := range scripts {
+		script.registerNatives()
+	}
+
+	return nil
+}
+
+// buildNativeList rebuilds nativeList from goNatives.
+func buildNativeList() {
+	size := C.size_t(len(goNatives)+1) * C.sizeof_AMX_NATIVE_INFO
+	list := (*[C.SAMPGO_MAX_NATIVES + 1]C.AMX_NATIVE_INFO)(C.malloc(size))[: len(goNatives)+1 : len(goNatives)+1]
+
+	for i, n := range goNatives {
+		list[i].name = C.nonConstToConst(n.cname)
+		list[i]._func = C.goNative(C.int(i))
+	}
+	list[len(goNatives)].name = nil
+	list[len(goNatives)]._func = nil
+
+	old := nativeList
+	nativeList = &list[0]
+	if old != nil {
+		C.free(unsafe.Pointer(old))
+	}
+}
+
+func (a *AMX) registerNatives() {
+	if nativeList == nil {
+		return
+	}
+	C.amx_Register(a.amx, nativeList, C.int(len(goNatives)))
+}
+
+//export callNative
+func callNative(index C.int, amx *C.AMX, params *C.cell) (ret C.cell) {
+	n := &goNatives[index]
+	if n.disabled {
+		return 0
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			n.failures++
+			ReportFailure("Native", n.failures, func() { n.disabled = true }, fmt.Errorf("panic: %v", r), debug.Stack(), "native", n.name)
+			ret = 0
+		}
+	}()
+
+	all := nativeParams(params)
+	args := (*[maxCells]Cell)(unsafe.Pointer(&all[0]))[1:len(all):len(all)]
+
+	return C.cell(n.fn(scriptFor(amx), args))
+}
+
+// WriteNativeInclude writes a Pawn include declaring every registered native,
+// such as include/sampgo_natives.inc next to sampgo.inc.
+func WriteNativeInclude(path string) error {
+	var b strings.Builder
+
+	b.WriteString("// Generated by sampgo, do not edit.\n\n")
+	b.WriteString("#if defined _sampgo_natives_included\n\t#endinput\n#endif\n\n")
+	b.WriteString("#define _sampgo_natives_included\n\n")
+
+	for _, n := range goNatives {
+		b.WriteString("native ")
+		b.WriteString(n.name)
+		b.WriteRune('(')
+		b.WriteString(strings.Join(n.params, ", "))
+		b.WriteString(");\n")
+	}
+
+	return ioutil.WriteFile(path, []byte(b.String()), 0666)
+}
@@ -0,0 +1,254 @@
+package sampgo
+
+import (
+	"fmt"
+	"sort"
+)
+
+// GangZone implements OO gang zones. It keeps track of the players it is
+// shown and flashing for, players disconnecting no longer see it.
+type GangZone struct {
+	id                     int
+	minX, minY, maxX, maxY float32
+	color                  int
+	global                 bool
+
+	// flashAll is set by FlashForAll, so global zones also flash for players
+	// connecting later.
+	flashAll   bool
+	flashColor int
+
+	viewers  map[int]struct{}
+	flashing map[int]int
+}
+
+var (
+	gangZones       = make(map[int]*GangZone)
+	gangZonesHooked bool
+)
+
+// NewGangZone creates a gang zone from its south-west to its north-east
+// corner, shown in color once shown for a player.
+func NewGangZone(minX, minY, maxX, maxY float32, color int) (*GangZone, error) {
+	if minX > maxX || minY > maxY {
+		return nil, fmt.Errorf("invalid gang zone bounds (%v, %v) to (%v, %v)", minX, minY, maxX, maxY)
+	}
+
+	z := &GangZone{
+		id:       GangZoneCreate(minX, minY, maxX, maxY),
+		minX:     minX,
+		minY:     minY,
+		maxX:     maxX,
+		maxY:     maxY,
+		color:    color,
+		viewers:  make(map[int]struct{}),
+		flashing: make(map[int]int),
+	}
+	if z.id == InvalidGangZone {
+		return nil, fmt.Errorf("can not create gang zone: %w", ErrLimitReached)
+	}
+
+	hookGangZones()
+	gangZones[z.id] = z
+	return z, nil
+}
+
+func (z *GangZone) GetID() int {
+	return z.id
+}
+
+// Destroy destroys the gang zone, hiding it for everyone.
+func (z *GangZone) Destroy() error {
+	if !GangZoneDestroy(z.id) {
+		return gangZoneError(z.id, ErrInvalidGangZone)
+	}
+
+	delete(gangZones, z.id)
+	z.viewers = make(map[int]struct{})
+	z.flashing = make(map[int]int)
+	return nil
+}
+
+// Bounds returns the south-west and north-east corners of the zone.
+func (z *GangZone) Bounds() (minX, minY, maxX, maxY float32) {
+	return z.minX, z.minY, z.maxX, z.maxY
+}
+
+// Contains reports whether the point lies within the zone, borders included.
+func (z *GangZone) Contains(x, y float32) bool {
+	return x >= z.minX && x <= z.maxX && y >= z.minY && y <= z.maxY
+}
+
+func (z *GangZone) GetColor() int {
+	return z.color
+}
+
+// SetColor changes the colour of the zone, updating it for the players that
+// see it.
+func (z *GangZone) SetColor(color int) {
+	z.color = color
+	for id := range z.viewers {
+		GangZoneShowForPlayer(id, z.id, z.color)
+		if flashColor, ok := z.flashing[id]; ok {
+			GangZoneFlashForPlayer(id, z.id, flashColor)
+		}
+	}
+}
+
+// IsGlobal reports whether the zone is shown for players when they connect.
+func (z *GangZone) IsGlobal() bool {
+	return z.global
+}
+
+// SetGlobal sets whether the zone is shown for players when they connect. It
+// does not show or hide the zone for connected players, use ShowForAll and
+// HideForAll for that.
+func (z *GangZone) SetGlobal(global bool) {
+	z.global = global
+}
+
+func (z *GangZone) ShowFor(p *Player) error {
+	if !GangZoneShowForPlayer(p.ID, z.id, z.color) {
+		return z.err(p)
+	}
+	z.viewers[p.ID] = struct{}{}
+	return nil
+}
+
+// HideFor hides the zone for the player, which also stops it flashing.
+func (z *GangZone) HideFor(p *Player) error {
+	if !GangZoneHideForPlayer(p.ID, z.id) {
+		return z.err(p)
+	}
+	delete(z.viewers, p.ID)
+	delete(z.flashing, p.ID)
+	return nil
+}
+
+// ShowForAll shows the zone for every connected player. Use SetGlobal to show
+// it for players connecting later as well.
+func (z *GangZone) ShowForAll() error {
+	if !GangZoneShowForAll(z.id, z.color) {
+		return gangZoneError(z.id, ErrInvalidGangZone)
+	}
+	for id := 0; id <= GetPlayerPoolSize(); id++ {
+		if IsPlayerConnected(id) {
+			z.viewers[id] = struct{}{}
+		}
+	}
+	return nil
+}
+
+func (z *GangZone) HideForAll() error {
+	if !GangZoneHideForAll(z.id) {
+		return gangZoneError(z.id, ErrInvalidGangZone)
+	}
+	z.viewers = make(map[int]struct{})
+	z.flashing = make(map[int]int)
+	z.flashAll = false
+	return nil
+}
+
+// FlashFor flashes the zone in color for the player. The zone must be shown
+// for the player to be seen.
+func (z *GangZone) FlashFor(p *Player, color int) error {
+	if !GangZoneFlashForPlayer(p.ID, z.id, color) {
+		return z.err(p)
+	}
+	z.flashing[p.ID] = color
+	return nil
+}
+
+func (z *GangZone) StopFlashFor(p *Player) error {
+	if !GangZoneStopFlashForPlayer(p.ID, z.id) {
+		return z.err(p)
+	}
+	delete(z.flashing, p.ID)
+	return nil
+}
+
+// FlashForAll flashes the zone in color for every connected player, and for
+// players connecting later if the zone is global.
+func (z *GangZone) FlashForAll(color int) error {
+	if !GangZoneFlashForAll(z.id, color) {
+		return gangZoneError(z.id, ErrInvalidGangZone)
+	}
+	for id := 0; id <= GetPlayerPoolSize(); id++ {
+		if IsPlayerConnected(id) {
+			z.flashing[id] = color
+		}
+	}
+	z.flashAll, z.flashColor = true, color
+	return nil
+}
+
+func (z *GangZone) StopFlashForAll() error {
+	if !GangZoneStopFlashForAll(z.id) {
+		return gangZoneError(z.id, ErrInvalidGangZone)
+	}
+	z.flashing = make(map[int]int)
+	z.flashAll = false
+	return nil
+}
+
+// IsShownFor reports whether the player currently sees the zone.
+func (z *GangZone) IsShownFor(p *Player) bool {
+	_, ok := z.viewers[p.ID]
+	return ok
+}
+
+// FlashingFor returns the colour the zone is flashing in for the player, and
+// whether it is flashing at all.
+func (z *GangZone) FlashingFor(p *Player) (color int, ok bool) {
+	color, ok = z.flashing[p.ID]
+	return
+}
+
+// Viewers returns the players currently seeing the zone, ordered by ID.
+func (z *GangZone) Viewers() []Player {
+	viewers := make([]Player, 0, len(z.viewers))
+	for id := range z.viewers {
+		viewers = append(viewers, Player{ID: id})
+	}
+	sort.Slice(viewers, func(i, j int) bool {
+		return viewers[i].ID < viewers[j].ID
+	})
+	return viewers
+}
+
+// err returns the error for a failed call for the player, telling a
+// disconnected player apart from an invalid gang zone.
+func (z *GangZone) err(p *Player) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return gangZoneError(z.id, ErrInvalidGangZone)
+}
+
+// hookGangZones registers the handlers showing global zones for connecting
+// players and tracking disconnects the first time a zone is created.
+func hookGangZones() {
+	if gangZonesHooked {
+		return
+	}
+	gangZonesHooked = true
+
+	_, _ = OnWithPriority("playerConnect", PriorityHighest, func(p Player) bool {
+		for _, z := range gangZones {
+			if !z.global {
+				continue
+			}
+			if z.ShowFor(&p) == nil && z.flashAll {
+				_ = z.FlashFor(&p, z.flashColor)
+			}
+		}
+		return true
+	})
+
+	onPlayerGone(func(playerID int) {
+		for _, z := range gangZones {
+			delete(z.viewers, playerID)
+			delete(z.flashing, playerID)
+		}
+	})
+}
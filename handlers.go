@@ -0,0 +1,409 @@
+package sampgo
+
+import "reflect"
+
+// eventSignatures maps every built-in event to the type its handlers must have.
+var eventSignatures = map[string]reflect.Type{
+	"tick":                      reflect.TypeOf((func())(nil)),
+	"scriptLoad":                reflect.TypeOf((func(*AMX))(nil)),
+	"scriptUnload":              reflect.TypeOf((func(*AMX))(nil)),
+	"goModeInit":                reflect.TypeOf((func() bool)(nil)),
+	"goModeExit":                reflect.TypeOf((func() bool)(nil)),
+	"playerConnect":             reflect.TypeOf((func(Player) bool)(nil)),
+	"playerDisconnect":          reflect.TypeOf((func(Player, int) bool)(nil)),
+	"playerSpawn":               reflect.TypeOf((func(Player) bool)(nil)),
+	"playerDeath":               reflect.TypeOf((func(Player, Player, int) bool)(nil)),
+	"vehicleSpawn":              reflect.TypeOf((func(int) bool)(nil)),
+	"vehicleDeath":              reflect.TypeOf((func(int, Player) bool)(nil)),
+	"playerText":                reflect.TypeOf((func(Player, string) bool)(nil)),
+	"playerCommandText":         reflect.TypeOf((func(Player, string) bool)(nil)),
+	"playerRequestClass":        reflect.TypeOf((func(Player, int) bool)(nil)),
+	"playerEnterVehicle":        reflect.TypeOf((func(Player, int, bool) bool)(nil)),
+	"playerExitVehicle":         reflect.TypeOf((func(Player, int) bool)(nil)),
+	"playerStateChange":         reflect.TypeOf((func(Player, int, int) bool)(nil)),
+	"playerEnterCheckpoint":     reflect.TypeOf((func(Player) bool)(nil)),
+	"playerLeaveCheckpoint":     reflect.TypeOf((func(Player) bool)(nil)),
+	"playerEnterRaceCheckpoint": reflect.TypeOf((func(Player) bool)(nil)),
+	"playerLeaveRaceCheckpoint": reflect.TypeOf((func(Player) bool)(nil)),
+	"rconCommand":               reflect.TypeOf((func(string) bool)(nil)),
+	"playerRequestSpawn":        reflect.TypeOf((func(Player) bool)(nil)),
+	"objectMoved":               reflect.TypeOf((func(int) bool)(nil)),
+	"playerObjectMoved":         reflect.TypeOf((func(Player, int) bool)(nil)),
+	"playerPickUpPickup":        reflect.TypeOf((func(Player, int) bool)(nil)),
+	"vehicleMod":                reflect.TypeOf((func(Player, int, int) bool)(nil)),
+	"enterExitModShop":          reflect.TypeOf((func(Player, bool, int) bool)(nil)),
+	"vehiclePaintjob":           reflect.TypeOf((func(Player, int, int) bool)(nil)),
+	"vehicleRespray":            reflect.TypeOf((func(Player, int, int, int) bool)(nil)),
+	"vehicleDamageStatusUpdate": reflect.TypeOf((func(int, Player) bool)(nil)),
+	"unoccupiedVehicleUpdate":   reflect.TypeOf((func(int, Player, int, float32, float32, float32, float32, float32, float32) bool)(nil)),
+	"playerSelectedMenuRow":     reflect.TypeOf((func(Player, int) bool)(nil)),
+	"playerExitedMenu":          reflect.TypeOf((func(Player) bool)(nil)),
+	"playerInteriorChange":      reflect.TypeOf((func(Player, int, int) bool)(nil)),
+	"playerKeyStateChange":      reflect.TypeOf((func(Player, int, int) bool)(nil)),
+	"rconLoginAttempt":          reflect.TypeOf((func(string, string, bool) bool)(nil)),
+	"playerUpdate":              reflect.TypeOf((func(Player) bool)(nil)),
+	"playerStreamIn":            reflect.TypeOf((func(Player, int) bool)(nil)),
+	"playerStreamOut":           reflect.TypeOf((func(Player, int) bool)(nil)),
+	"vehicleStreamIn":           reflect.TypeOf((func(int, int) bool)(nil)),
+	"vehicleStreamOut":          reflect.TypeOf((func(int, int) bool)(nil)),
+	"actorStreamIn":             reflect.TypeOf((func(int, int) bool)(nil)),
+	"actorStreamOut":            reflect.TypeOf((func(int, int) bool)(nil)),
+	"dialogResponse":            reflect.TypeOf((func(Player, int, int, int, string) bool)(nil)),
+	"playerTakeDamage":          reflect.TypeOf((func(Player, Player, float32, int, int) bool)(nil)),
+	"playerGiveDamage":          reflect.TypeOf((func(Player, int, float32, int, int) bool)(nil)),
+	"playerGiveDamageActor":     reflect.TypeOf((func(Player, int, float32, int, int) bool)(nil)),
+	"playerClickMap":            reflect.TypeOf((func(Player, float32, float32, float32) bool)(nil)),
+	"playerClickTextDraw":       reflect.TypeOf((func(Player, int) bool)(nil)),
+	"playerClickPlayerTextDraw": reflect.TypeOf((func(Player, int) bool)(nil)),
+	"incomingConnection":        reflect.TypeOf((func(Player, string, int) bool)(nil)),
+	"trailerUpdate":             reflect.TypeOf((func(Player, int) bool)(nil)),
+	"vehicleSirenStateChange":   reflect.TypeOf((func(Player, int, int) bool)(nil)),
+	"playerClickPlayer":         reflect.TypeOf((func(Player, int, int) bool)(nil)),
+	"playerEditObject":          reflect.TypeOf((func(Player, bool, int, int, float32, float32, float32, float32, float32, float32) bool)(nil)),
+	"playerEditAttachedObject":  reflect.TypeOf((func(Player, int, int, int, int, float32, float32, float32, float32, float32, float32, float32, float32, float32) bool)(nil)),
+	"playerSelectObject":        reflect.TypeOf((func(Player, int, int, int, float32, float32, float32) bool)(nil)),
+	"playerWeaponShot":          reflect.TypeOf((func(Player, int, int, int, float32, float32, float32) bool)(nil)),
+	"playerRequestDownload":     reflect.TypeOf((func(Player, int, int) bool)(nil)),
+}
+
+// OnGameModeInit registers a handler for the goModeInit event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnGameModeInit
+func OnGameModeInit(handler func() bool) (*Subscription, error) {
+	return On("goModeInit", handler)
+}
+
+// OnGameModeExit registers a handler for the goModeExit event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnGameModeExit
+func OnGameModeExit(handler func() bool) (*Subscription, error) {
+	return On("goModeExit", handler)
+}
+
+// OnPlayerConnect registers a handler for the playerConnect event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerConnect
+func OnPlayerConnect(handler func(player Player) bool) (*Subscription, error) {
+	return On("playerConnect", handler)
+}
+
+// OnPlayerDisconnect registers a handler for the playerDisconnect event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerDisconnect
+func OnPlayerDisconnect(handler func(player Player, reason int) bool) (*Subscription, error) {
+	return On("playerDisconnect", handler)
+}
+
+// OnPlayerSpawn registers a handler for the playerSpawn event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerSpawn
+func OnPlayerSpawn(handler func(player Player) bool) (*Subscription, error) {
+	return On("playerSpawn", handler)
+}
+
+// OnPlayerDeath registers a handler for the playerDeath event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerDeath
+func OnPlayerDeath(handler func(player Player, killer Player, reason int) bool) (*Subscription, error) {
+	return On("playerDeath", handler)
+}
+
+// OnVehicleSpawn registers a handler for the vehicleSpawn event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnVehicleSpawn
+func OnVehicleSpawn(handler func(vehicleid int) bool) (*Subscription, error) {
+	return On("vehicleSpawn", handler)
+}
+
+// OnVehicleDeath registers a handler for the vehicleDeath event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnVehicleDeath
+func OnVehicleDeath(handler func(vehicleid int, killer Player) bool) (*Subscription, error) {
+	return On("vehicleDeath", handler)
+}
+
+// OnPlayerText registers a handler for the playerText event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerText
+func OnPlayerText(handler func(player Player, text string) bool) (*Subscription, error) {
+	return On("playerText", handler)
+}
+
+// OnPlayerCommandText registers a handler for the playerCommandText event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerCommandText
+func OnPlayerCommandText(handler func(player Player, cmdtext string) bool) (*Subscription, error) {
+	return On("playerCommandText", handler)
+}
+
+// OnPlayerRequestClass registers a handler for the playerRequestClass event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerRequestClass
+func OnPlayerRequestClass(handler func(player Player, classid int) bool) (*Subscription, error) {
+	return On("playerRequestClass", handler)
+}
+
+// OnPlayerEnterVehicle registers a handler for the playerEnterVehicle event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerEnterVehicle
+func OnPlayerEnterVehicle(handler func(player Player, vehicleid int, ispassenger bool) bool) (*Subscription, error) {
+	return On("playerEnterVehicle", handler)
+}
+
+// OnPlayerExitVehicle registers a handler for the playerExitVehicle event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerExitVehicle
+func OnPlayerExitVehicle(handler func(player Player, vehicleid int) bool) (*Subscription, error) {
+	return On("playerExitVehicle", handler)
+}
+
+// OnPlayerStateChange registers a handler for the playerStateChange event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerStateChange
+func OnPlayerStateChange(handler func(player Player, newstate int, oldstate int) bool) (*Subscription, error) {
+	return On("playerStateChange", handler)
+}
+
+// OnPlayerEnterCheckpoint registers a handler for the playerEnterCheckpoint event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerEnterCheckpoint
+func OnPlayerEnterCheckpoint(handler func(player Player) bool) (*Subscription, error) {
+	return On("playerEnterCheckpoint", handler)
+}
+
+// OnPlayerLeaveCheckpoint registers a handler for the playerLeaveCheckpoint event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerLeaveCheckpoint
+func OnPlayerLeaveCheckpoint(handler func(player Player) bool) (*Subscription, error) {
+	return On("playerLeaveCheckpoint", handler)
+}
+
+// OnPlayerEnterRaceCheckpoint registers a handler for the playerEnterRaceCheckpoint event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerEnterRaceCheckpoint
+func OnPlayerEnterRaceCheckpoint(handler func(player Player) bool) (*Subscription, error) {
+	return On("playerEnterRaceCheckpoint", handler)
+}
+
+// OnPlayerLeaveRaceCheckpoint registers a handler for the playerLeaveRaceCheckpoint event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerLeaveRaceCheckpoint
+func OnPlayerLeaveRaceCheckpoint(handler func(player Player) bool) (*Subscription, error) {
+	return On("playerLeaveRaceCheckpoint", handler)
+}
+
+// OnRconCommand registers a handler for the rconCommand event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnRconCommand
+func OnRconCommand(handler func(cmd string) bool) (*Subscription, error) {
+	return On("rconCommand", handler)
+}
+
+// OnPlayerRequestSpawn registers a handler for the playerRequestSpawn event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerRequestSpawn
+func OnPlayerRequestSpawn(handler func(player Player) bool) (*Subscription, error) {
+	return On("playerRequestSpawn", handler)
+}
+
+// OnObjectMoved registers a handler for the objectMoved event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnObjectMoved
+func OnObjectMoved(handler func(objectid int) bool) (*Subscription, error) {
+	return On("objectMoved", handler)
+}
+
+// OnPlayerObjectMoved registers a handler for the playerObjectMoved event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerObjectMoved
+func OnPlayerObjectMoved(handler func(player Player, objectid int) bool) (*Subscription, error) {
+	return On("playerObjectMoved", handler)
+}
+
+// OnPlayerPickUpPickup registers a handler for the playerPickUpPickup event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerPickUpPickup
+func OnPlayerPickUpPickup(handler func(player Player, pickupid int) bool) (*Subscription, error) {
+	return On("playerPickUpPickup", handler)
+}
+
+// OnVehicleMod registers a handler for the vehicleMod event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnVehicleMod
+func OnVehicleMod(handler func(player Player, vehicleid int, componentid int) bool) (*Subscription, error) {
+	return On("vehicleMod", handler)
+}
+
+// OnEnterExitModShop registers a handler for the enterExitModShop event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnEnterExitModShop
+func OnEnterExitModShop(handler func(player Player, enterexit bool, interiorid int) bool) (*Subscription, error) {
+	return On("enterExitModShop", handler)
+}
+
+// OnVehiclePaintjob registers a handler for the vehiclePaintjob event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnVehiclePaintjob
+func OnVehiclePaintjob(handler func(player Player, vehicleid int, paintjobid int) bool) (*Subscription, error) {
+	return On("vehiclePaintjob", handler)
+}
+
+// OnVehicleRespray registers a handler for the vehicleRespray event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnVehicleRespray
+func OnVehicleRespray(handler func(player Player, vehicleid int, color1 int, color2 int) bool) (*Subscription, error) {
+	return On("vehicleRespray", handler)
+}
+
+// OnVehicleDamageStatusUpdate registers a handler for the vehicleDamageStatusUpdate event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnVehicleDamageStatusUpdate
+func OnVehicleDamageStatusUpdate(handler func(vehicleid int, player Player) bool) (*Subscription, error) {
+	return On("vehicleDamageStatusUpdate", handler)
+}
+
+// OnUnoccupiedVehicleUpdate registers a handler for the unoccupiedVehicleUpdate event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnUnoccupiedVehicleUpdate
+func OnUnoccupiedVehicleUpdate(handler func(vehicleid int, player Player, passenger_seat int, new_x float32, new_y float32, new_z float32, vel_x float32, vel_y float32, vel_z float32) bool) (*Subscription, error) {
+	return On("unoccupiedVehicleUpdate", handler)
+}
+
+// OnPlayerSelectedMenuRow registers a handler for the playerSelectedMenuRow event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerSelectedMenuRow
+func OnPlayerSelectedMenuRow(handler func(player Player, row int) bool) (*Subscription, error) {
+	return On("playerSelectedMenuRow", handler)
+}
+
+// OnPlayerExitedMenu registers a handler for the playerExitedMenu event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerExitedMenu
+func OnPlayerExitedMenu(handler func(player Player) bool) (*Subscription, error) {
+	return On("playerExitedMenu", handler)
+}
+
+// OnPlayerInteriorChange registers a handler for the playerInteriorChange event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerInteriorChange
+func OnPlayerInteriorChange(handler func(player Player, newinteriorid int, oldinteriorid int) bool) (*Subscription, error) {
+	return On("playerInteriorChange", handler)
+}
+
+// OnPlayerKeyStateChange registers a handler for the playerKeyStateChange event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerKeyStateChange
+func OnPlayerKeyStateChange(handler func(player Player, newkeys int, oldkeys int) bool) (*Subscription, error) {
+	return On("playerKeyStateChange", handler)
+}
+
+// OnRconLoginAttempt registers a handler for the rconLoginAttempt event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnRconLoginAttempt
+func OnRconLoginAttempt(handler func(ip string, password string, success bool) bool) (*Subscription, error) {
+	return On("rconLoginAttempt", handler)
+}
+
+// OnPlayerUpdate registers a handler for the playerUpdate event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerUpdate
+func OnPlayerUpdate(handler func(player Player) bool) (*Subscription, error) {
+	return On("playerUpdate", handler)
+}
+
+// OnPlayerStreamIn registers a handler for the playerStreamIn event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerStreamIn
+func OnPlayerStreamIn(handler func(player Player, forplayerid int) bool) (*Subscription, error) {
+	return On("playerStreamIn", handler)
+}
+
+// OnPlayerStreamOut registers a handler for the playerStreamOut event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerStreamOut
+func OnPlayerStreamOut(handler func(player Player, forplayerid int) bool) (*Subscription, error) {
+	return On("playerStreamOut", handler)
+}
+
+// OnVehicleStreamIn registers a handler for the vehicleStreamIn event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnVehicleStreamIn
+func OnVehicleStreamIn(handler func(vehicleid int, forplayerid int) bool) (*Subscription, error) {
+	return On("vehicleStreamIn", handler)
+}
+
+// OnVehicleStreamOut registers a handler for the vehicleStreamOut event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnVehicleStreamOut
+func OnVehicleStreamOut(handler func(vehicleid int, forplayerid int) bool) (*Subscription, error) {
+	return On("vehicleStreamOut", handler)
+}
+
+// OnActorStreamIn registers a handler for the actorStreamIn event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnActorStreamIn
+func OnActorStreamIn(handler func(actorid int, forplayerid int) bool) (*Subscription, error) {
+	return On("actorStreamIn", handler)
+}
+
+// OnActorStreamOut registers a handler for the actorStreamOut event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnActorStreamOut
+func OnActorStreamOut(handler func(actorid int, forplayerid int) bool) (*Subscription, error) {
+	return On("actorStreamOut", handler)
+}
+
+// OnDialogResponse registers a handler for the dialogResponse event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnDialogResponse
+func OnDialogResponse(handler func(player Player, dialogid int, response int, listitem int, inputtext string) bool) (*Subscription, error) {
+	return On("dialogResponse", handler)
+}
+
+// OnPlayerTakeDamage registers a handler for the playerTakeDamage event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerTakeDamage
+func OnPlayerTakeDamage(handler func(player Player, issuer Player, amount float32, weaponid int, bodypart int) bool) (*Subscription, error) {
+	return On("playerTakeDamage", handler)
+}
+
+// OnPlayerGiveDamage registers a handler for the playerGiveDamage event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerGiveDamage
+func OnPlayerGiveDamage(handler func(player Player, damagedid int, amount float32, weaponid int, bodypart int) bool) (*Subscription, error) {
+	return On("playerGiveDamage", handler)
+}
+
+// OnPlayerGiveDamageActor registers a handler for the playerGiveDamageActor event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerGiveDamageActor
+func OnPlayerGiveDamageActor(handler func(player Player, damaged_actorid int, amount float32, weaponid int, bodypart int) bool) (*Subscription, error) {
+	return On("playerGiveDamageActor", handler)
+}
+
+// OnPlayerClickMap registers a handler for the playerClickMap event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerClickMap
+func OnPlayerClickMap(handler func(player Player, fX float32, fY float32, fZ float32) bool) (*Subscription, error) {
+	return On("playerClickMap", handler)
+}
+
+// OnPlayerClickTextDraw registers a handler for the playerClickTextDraw event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerClickTextDraw
+func OnPlayerClickTextDraw(handler func(player Player, clickedid int) bool) (*Subscription, error) {
+	return On("playerClickTextDraw", handler)
+}
+
+// OnPlayerClickPlayerTextDraw registers a handler for the playerClickPlayerTextDraw event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerClickPlayerTextDraw
+func OnPlayerClickPlayerTextDraw(handler func(player Player, playertextid int) bool) (*Subscription, error) {
+	return On("playerClickPlayerTextDraw", handler)
+}
+
+// OnIncomingConnection registers a handler for the incomingConnection event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnIncomingConnection
+func OnIncomingConnection(handler func(player Player, ip_address string, port int) bool) (*Subscription, error) {
+	return On("incomingConnection", handler)
+}
+
+// OnTrailerUpdate registers a handler for the trailerUpdate event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnTrailerUpdate
+func OnTrailerUpdate(handler func(player Player, vehicleid int) bool) (*Subscription, error) {
+	return On("trailerUpdate", handler)
+}
+
+// OnVehicleSirenStateChange registers a handler for the vehicleSirenStateChange event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnVehicleSirenStateChange
+func OnVehicleSirenStateChange(handler func(player Player, vehicleid int, newstate int) bool) (*Subscription, error) {
+	return On("vehicleSirenStateChange", handler)
+}
+
+// OnPlayerClickPlayer registers a handler for the playerClickPlayer event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerClickPlayer
+func OnPlayerClickPlayer(handler func(player Player, clickedplayerid int, source int) bool) (*Subscription, error) {
+	return On("playerClickPlayer", handler)
+}
+
+// OnPlayerEditObject registers a handler for the playerEditObject event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerEditObject
+func OnPlayerEditObject(handler func(player Player, playerobject bool, objectid int, response int, fX float32, fY float32, fZ float32, fRotX float32, fRotY float32, fRotZ float32) bool) (*Subscription, error) {
+	return On("playerEditObject", handler)
+}
+
+// OnPlayerEditAttachedObject registers a handler for the playerEditAttachedObject event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerEditAttachedObject
+func OnPlayerEditAttachedObject(handler func(player Player, response int, index int, modelid int, boneid int, fOffsetX float32, fOffsetY float32, fOffsetZ float32, fRotX float32, fRotY float32, fRotZ float32, fScaleX float32, fScaleY float32, fScaleZ float32) bool) (*Subscription, error) {
+	return On("playerEditAttachedObject", handler)
+}
+
+// OnPlayerSelectObject registers a handler for the playerSelectObject event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerSelectObject
+func OnPlayerSelectObject(handler func(player Player, type_ int, objectid int, modelid int, fX float32, fY float32, fZ float32) bool) (*Subscription, error) {
+	return On("playerSelectObject", handler)
+}
+
+// OnPlayerWeaponShot registers a handler for the playerWeaponShot event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerWeaponShot
+func OnPlayerWeaponShot(handler func(player Player, weaponid int, hittype int, hitid int, fX float32, fY float32, fZ float32) bool) (*Subscription, error) {
+	return On("playerWeaponShot", handler)
+}
+
+// OnPlayerRequestDownload registers a handler for the playerRequestDownload event.
+// For documentation, please visit https://open.mp/docs/scripting/callbacks/OnPlayerRequestDownload
+func OnPlayerRequestDownload(handler func(player Player, type_ int, crc int) bool) (*Subscription, error) {
+	return On("playerRequestDownload", handler)
+}
@@ -0,0 +1,157 @@
+package sampgo
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// eventDecl describes the arguments Pawn passes to a custom event.
+type eventDecl struct {
+	format string
+	specs  []argSpec
+	params []string
+}
+
+var eventDecls = make(map[string]eventDecl)
+
+// DeclareEvent declares the arguments of the custom event name, in the format
+// used by sampgo_CallEvent, together with their Pawn names. params may be left
+// out, in which case the arguments are called arg0, arg1 and so on. Declared
+// events are written to the Pawn include by WriteEventInclude.
+//
+//	sampgo.DeclareEvent("bankDeposit", "iis", "playerid", "amount", "note")
+func DeclareEvent(name, format string, params ...string) error {
+	if _, ok := eventSignatures[name]; ok {
+		return fmt.Errorf("%s is a built-in event", name)
+	}
+	// The generated stock is named Go_On followed by the event name.
+	if !isPawnIdent("Go_On" + name) {
+		return fmt.Errorf("event name %q is not a valid Pawn identifier of at most 26 characters", name)
+	}
+
+	specs, err := parseFormat(format)
+	if err != nil {
+		return fmt.Errorf("event %s: %w", name, err)
+	}
+
+	if len(params) == 0 {
+		for i := range specs {
+			params = append(params, fmt.Sprintf("arg%d", i))
+		}
+	}
+	if len(params) != len(specs) {
+		return fmt.Errorf("event %s: format %q has %d arguments but %d names were given", name, format, len(specs), len(params))
+	}
+	for _, param := range params {
+		if !isPawnIdent(param) {
+			return fmt.Errorf("event %s: parameter %q is not a valid Pawn identifier", name, param)
+		}
+	}
+
+	eventDecls[name] = eventDecl{format: format, specs: specs, params: params}
+	return nil
+}
+
+// WriteEventInclude writes a Pawn include with a typed stock for every
+// declared event, such as include/sampgo_events.inc next to sampgo.inc. The
+// event bankDeposit declared as above becomes
+//
+//	stock Go_OnBankDeposit(playerid, amount, const note[])
+//
+// so the Pawn compiler catches calls that do not match the Go side.
+func WriteEventInclude(path string) error {
+	names := make([]string, 0, len(eventDecls))
+	for name := range eventDecls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+
+	b.WriteString("// Generated by sampgo, do not edit.\n\n")
+	b.WriteString("#if defined _sampgo_events_included\n\t#endinput\n#endif\n\n")
+	b.WriteString("#define _sampgo_events_included\n\n")
+	b.WriteString("#include <sampgo>\n")
+
+	for _, name := range names {
+		decl := eventDecls[name]
+
+		if len(events[name]) == 0 {
+			logger.Warn("Event is declared but has no handler", "event", name)
+		}
+
+		params := make([]string, len(decl.specs))
+		for i, spec := range decl.specs {
+			params[i] = spec.pawnParam(decl.params[i])
+		}
+
+		b.WriteString("\nstock Go_On")
+		b.WriteString(strings.ToUpper(name[:1]) + name[1:])
+		b.WriteRune('(')
+		b.WriteString(strings.Join(params, ", "))
+		b.WriteString(")\n{\n\treturn _:sampgo_CallEvent(\"")
+		b.WriteString(name)
+		b.WriteString("\", \"")
+		b.WriteString(decl.format)
+		b.WriteRune('"')
+		for i, param := range decl.params {
+			b.WriteString(", ")
+			b.WriteString(decl.specs[i].pawnArg(param))
+		}
+		b.WriteString(");\n}\n")
+	}
+
+	return ioutil.WriteFile(path, []byte(b.String()), 0666)
+}
+
+// pawnParam returns the Pawn declaration of a parameter called name.
+func (spec argSpec) pawnParam(name string) string {
+	switch spec.kind {
+	case 's':
+		return "const " + name + "[]"
+	case 'a':
+		if spec.ref {
+			return fmt.Sprintf("%s[%d]", name, spec.size)
+		}
+		return fmt.Sprintf("const %s[%d]", name, spec.size)
+	}
+
+	switch spec.kind {
+	case 'b':
+		name = "bool:" + name
+	case 'f':
+		name = "Float:" + name
+	}
+	if spec.ref {
+		name = "&" + name
+	}
+	return name
+}
+
+// pawnArg returns the parameter called name as forwarded to sampgo_CallEvent.
+// bool is not among the variadic's {Float,_} tags, so it is retagged to avoid a
+// tag mismatch warning; references stay references.
+func (spec argSpec) pawnArg(name string) string {
+	if spec.kind == 'b' {
+		return "_:" + name
+	}
+	return name
+}
+
+// isPawnIdent reports whether s can be used as a Pawn symbol name.
+func isPawnIdent(s string) bool {
+	if s == "" || len(s) > 31 {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_' || r == '@' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z'):
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
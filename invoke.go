@@ -0,0 +1,306 @@
+package sampgo
+
+/*
+#cgo windows CFLAGS: -I./lib -I./lib/amx -Wno-attributes -Wno-implicit-function-declaration
+#cgo windows CFLAGS: -DHAVE_INTTYPES_H -DHAVE_MALLOC_H -DHAVE_STDINT_H -DWIN32
+#cgo windows LDFLAGS: -Wl,--subsystem,windows,--kill-at
+
+#cgo linux CFLAGS: -I./lib -I./lib/amx -Wno-attributes -Wno-implicit-function-declaration
+#cgo linux CFLAGS: -DHAVE_INTTYPES_H -DHAVE_MALLOC_H -DHAVE_STDINT_H -DLINUX -D_GNU_SOURCE
+#cgo linux LDFLAGS: -ldl
+
+#ifndef GOLANG_APP
+#define GOLANG_APP
+
+#include "main.h"
+
+#endif
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"unsafe"
+)
+
+// ErrNativeNotFound is returned when no script or plugin registered the native.
+var ErrNativeNotFound = errors.New("native function not found")
+
+// maxNativeArgs is the number of arguments sampgdk can pass to a native.
+const maxNativeArgs = 32
+
+var nativeCache = make(map[string]C.AMX_NATIVE)
+
+// CallNative calls a native registered by any plugin or the server, such as
+// Streamer's CreateDynamicObject, and returns its result. format uses the
+// sampgdk specifiers, one per argument:
+//
+//	i, d  int                   integer
+//	b     bool                  boolean
+//	f     float32 or float64    Float
+//	r     int, float32 or Cell  const reference
+//	R     *int, *float32, *Cell reference, written back after the call
+//	s     string                const string
+//	S[n]  *string               string buffer of n characters, written back after the call
+//	a[n]  []int, []float32 or []Cell  const array of n cells
+//	A[n]  []int, []float32 or []Cell  array of n cells, written back after the call
+//
+// The size n may also be given as *k to use the value of the k-th (zero based)
+// argument, like "S[*2]i" in GetPlayerName's "iS[*2]i".
+func CallNative(name, format string, args ...interface{}) (Cell, error) {
+	native, err := findNative(name)
+	if err != nil {
+		return 0, err
+	}
+
+	specs, err := parseNativeFormat(format, args)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", name, err)
+	}
+	if len(specs) != len(args) {
+		return 0, fmt.Errorf("%s: format %q has %d arguments but %d were given", name, format, len(specs), len(args))
+	}
+
+	// Everything handed to sampgdk must live in C memory.
+	argv := (*[maxNativeArgs]unsafe.Pointer)(C.malloc(C.size_t(maxNativeArgs) * C.size_t(unsafe.Sizeof(unsafe.Pointer(nil)))))
+	defer C.free(unsafe.Pointer(argv))
+
+	var allocated []unsafe.Pointer
+	defer func() {
+		for _, ptr := range allocated {
+			C.free(ptr)
+		}
+	}()
+
+	for i, spec := range specs {
+		ptr, err := spec.alloc(args[i])
+		if err != nil {
+			return 0, fmt.Errorf("%s: argument %d: %w", name, i, err)
+		}
+		allocated = append(allocated, ptr)
+		argv[i] = ptr
+	}
+
+	cformat := C.CString(format)
+	defer C.free(unsafe.Pointer(cformat))
+
+	ret := Cell(C.sampgdk_InvokeNativeArray(native, C.nonConstToConst(cformat), &argv[0]))
+
+	for i, spec := range specs {
+		spec.writeBack(argv[i], args[i])
+	}
+
+	return ret, nil
+}
+
+// findNative looks a native up by name, caching the result.
+func findNative(name string) (C.AMX_NATIVE, error) {
+	if native, ok := nativeCache[name]; ok {
+		return native, nil
+	}
+
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	native := C.sampgdk_FindNative(C.nonConstToConst(cname))
+	if native == nil {
+		return nil, fmt.Errorf("%s: %w", name, ErrNativeNotFound)
+	}
+
+	nativeCache[name] = native
+	return native, nil
+}
+
+// nativeSpec is a single argument of a sampgdk format string.
+type nativeSpec struct {
+	kind byte
+	size int
+}
+
+// parseNativeFormat parses a sampgdk format string, resolving sizes given as
+// *k from args.
+func parseNativeFormat(format string, args []interface{}) ([]nativeSpec, error) {
+	var specs []nativeSpec
+
+	for i := 0; i < len(format); i++ {
+		spec := nativeSpec{kind: format[i]}
+
+		switch spec.kind {
+		case 'i', 'd', 'b', 'f', 'r', 'R', 's':
+		case 'S', 'a', 'A':
+			if i+1 >= len(format) || format[i+1] != '[' {
+				return nil, fmt.Errorf("format %q: %c at %d is missing its [size]", format, spec.kind, i)
+			}
+			end := i + 2
+			for end < len(format) && format[end] != ']' {
+				end++
+			}
+			if end == len(format) {
+				return nil, fmt.Errorf("format %q: unterminated size at %d", format, i)
+			}
+
+			size := format[i+2 : end]
+			if len(size) > 0 && size[0] == '*' {
+				index, err := strconv.Atoi(size[1:])
+				if err != nil || index < 0 || index >= len(args) {
+					return nil, fmt.Errorf("format %q: invalid size argument %q", format, size)
+				}
+				n, ok := args[index].(int)
+				if !ok {
+					return nil, fmt.Errorf("format %q: size argument %d is %T, not int", format, index, args[index])
+				}
+				spec.size = n
+			} else {
+				n, err := strconv.Atoi(size)
+				if err != nil {
+					return nil, fmt.Errorf("format %q: invalid size %q", format, size)
+				}
+				spec.size = n
+			}
+			if spec.size < 1 {
+				return nil, fmt.Errorf("format %q: size must be positive", format)
+			}
+			i = end
+		default:
+			return nil, fmt.Errorf("format %q: unknown specifier %q", format, spec.kind)
+		}
+
+		specs = append(specs, spec)
+		if len(specs) > maxNativeArgs {
+			return nil, fmt.Errorf("format %q: more than %d arguments", format, maxNativeArgs)
+		}
+	}
+
+	return specs, nil
+}
+
+// alloc copies arg into newly allocated C memory in the layout sampgdk
+// expects for the specifier.
+func (spec nativeSpec) alloc(arg interface{}) (unsafe.Pointer, error) {
+	switch spec.kind {
+	case 'i', 'd':
+		n, ok := arg.(int)
+		if !ok {
+			return nil, fmt.Errorf("%c needs an int, got %T", spec.kind, arg)
+		}
+		ptr := C.malloc(C.sizeof_int)
+		*(*C.int)(ptr) = C.int(n)
+		return ptr, nil
+	case 'b':
+		b, ok := arg.(bool)
+		if !ok {
+			return nil, fmt.Errorf("b needs a bool, got %T", arg)
+		}
+		ptr := C.malloc(C.sizeof_bool)
+		*(*C.bool)(ptr) = C.bool(b)
+		return ptr, nil
+	case 'f':
+		var f float32
+		switch v := arg.(type) {
+		case float32:
+			f = v
+		case float64:
+			f = float32(v)
+		default:
+			return nil, fmt.Errorf("f needs a float32 or float64, got %T", arg)
+		}
+		ptr := C.malloc(C.sizeof_float)
+		*(*C.float)(ptr) = C.float(f)
+		return ptr, nil
+	case 'r', 'R':
+		var c C.cell
+		switch v := arg.(type) {
+		case int:
+			c = C.cell(v)
+		case float32:
+			c = floatToCell(v)
+		case Cell:
+			c = C.cell(v)
+		case *int:
+			c = C.cell(*v)
+		case *float32:
+			c = floatToCell(*v)
+		case *Cell:
+			c = C.cell(*v)
+		default:
+			return nil, fmt.Errorf("%c can not pass %T", spec.kind, arg)
+		}
+		if spec.kind == 'R' {
+			switch arg.(type) {
+			case *int, *float32, *Cell:
+			default:
+				return nil, fmt.Errorf("R needs a pointer to write back to, got %T", arg)
+			}
+		}
+		ptr := C.malloc(C.sizeof_cell)
+		*(*C.cell)(ptr) = c
+		return ptr, nil
+	case 's':
+		s, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("s needs a string, got %T", arg)
+		}
+		return unsafe.Pointer(C.CString(s)), nil
+	case 'S':
+		if _, ok := arg.(*string); !ok {
+			return nil, fmt.Errorf("S needs a *string, got %T", arg)
+		}
+		// sampgdk copies the buffer onto the AMX as size cells, so make it that large.
+		return C.calloc(C.size_t(spec.size), C.sizeof_cell), nil
+	default: // 'a', 'A'
+		array := cells((*C.cell)(C.calloc(C.size_t(spec.size), C.sizeof_cell)), spec.size)
+		switch v := arg.(type) {
+		case []int:
+			for i := 0; i < len(v) && i < spec.size; i++ {
+				array[i] = C.cell(v[i])
+			}
+		case []float32:
+			for i := 0; i < len(v) && i < spec.size; i++ {
+				array[i] = floatToCell(v[i])
+			}
+		case []Cell:
+			for i := 0; i < len(v) && i < spec.size; i++ {
+				array[i] = C.cell(v[i])
+			}
+		default:
+			C.free(unsafe.Pointer(&array[0]))
+			return nil, fmt.Errorf("%c needs a []int, []float32 or []Cell, got %T", spec.kind, arg)
+		}
+		return unsafe.Pointer(&array[0]), nil
+	}
+}
+
+// writeBack copies the output of R, S and A arguments back into arg.
+func (spec nativeSpec) writeBack(ptr unsafe.Pointer, arg interface{}) {
+	switch spec.kind {
+	case 'R':
+		c := *(*C.cell)(ptr)
+		switch v := arg.(type) {
+		case *int:
+			*v = int(c)
+		case *float32:
+			*v = cellToFloat(c)
+		case *Cell:
+			*v = Cell(c)
+		}
+	case 'S':
+		*(arg.(*string)) = C.GoString((*C.char)(ptr))
+	case 'A':
+		array := cells((*C.cell)(ptr), spec.size)
+		switch v := arg.(type) {
+		case []int:
+			for i := 0; i < len(v) && i < spec.size; i++ {
+				v[i] = int(array[i])
+			}
+		case []float32:
+			for i := 0; i < len(v) && i < spec.size; i++ {
+				v[i] = cellToFloat(array[i])
+			}
+		case []Cell:
+			for i := 0; i < len(v) && i < spec.size; i++ {
+				v[i] = Cell(array[i])
+			}
+		}
+	}
+}
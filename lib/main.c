@@ -5,48 +5,120 @@
 
 #include "main.h"
 
+#include <string.h>
+
+#ifdef _WIN32
+#include <windows.h>
+static DWORD main_thread;
+#else
+#include <pthread.h>
+static pthread_t main_thread;
+#endif
+
 AMX_NATIVE_INFO native_list[] = {
 	{ "sampgo_CallEvent", n_CallEvent },
+	{ "sampgo_CallEventStr", n_CallEventStr },
 	{ NULL, NULL }
 };
 
-// GoInt32: sampgo_CallEvent(const event[32], const format[], {Float,_}:...);
-cell AMX_NATIVE_CALL n_CallEvent(AMX* amx, cell* params)
+// Reads the string at the given AMX address into a newly allocated buffer.
+static char* get_string(AMX* amx, cell amx_addr, int* len)
+{
+    cell *addr  = NULL;
+
+    *len = 0;
+    amx_GetAddr(amx, amx_addr, &addr);
+    amx_StrLen(addr, len);
+
+    char* str = malloc( sizeof(char) * (*len + 1));
+    amx_GetString(str, addr, 0, *len + 1);
+    return str;
+}
+
+// Calls the Go event named by params[1]. The format is at params[first - 1] and
+// the event arguments start at params[first].
+static cell call_event(AMX* amx, cell* params, int first, cell* dest, int size)
 {
     int
         len = (int) NULL
     ;
 
-    cell *addr  = NULL;
-
-    amx_GetAddr(amx, params[1], &addr);
-    amx_StrLen(addr, &len);
+    char* event = get_string(amx, params[1], &len);
 
     if (!len) {
         sampgdk_logprintf("(C) sampgo: Empty event name passed to n_CallEvent");
+        free(event);
         return false;
     }
 
-    ++ len;
-    char* event = malloc( sizeof(char) * (len));
-    amx_GetString(event, addr, 0, len);
+    char* format = get_string(amx, params[first - 1], &len);
 
-    len = (int) NULL;
+    cell retval = callEvent(amx, event, format, params, first, dest, size);
 
-    amx_GetAddr(amx, params[2], &addr);
-    amx_StrLen(addr, &len);
+    free(event);
+    free(format);
+    return retval;
+}
 
-    ++ len;
-    char* format = malloc( sizeof(char) * (len));
-    amx_GetString(format, addr, 0, len);
+// Natives registered from Go. Every slot has its own function that forwards
+// the call to Go together with its index, as AMX natives carry no user data.
+#define GO_NATIVE(i) \
+    static cell AMX_NATIVE_CALL n_GoNative_##i(AMX* amx, cell* params) \
+    { \
+        return callNative(0x##i, amx, params); \
+    }
 
-    bool retval = callEvent(&amx, event, format, params);
+#define GO_NATIVES(h) \
+    GO_NATIVE(h##0) GO_NATIVE(h##1) GO_NATIVE(h##2) GO_NATIVE(h##3) \
+    GO_NATIVE(h##4) GO_NATIVE(h##5) GO_NATIVE(h##6) GO_NATIVE(h##7) \
+    GO_NATIVE(h##8) GO_NATIVE(h##9) GO_NATIVE(h##A) GO_NATIVE(h##B) \
+    GO_NATIVE(h##C) GO_NATIVE(h##D) GO_NATIVE(h##E) GO_NATIVE(h##F)
+
+#define GO_NATIVE_REFS(h) \
+    n_GoNative_##h##0, n_GoNative_##h##1, n_GoNative_##h##2, n_GoNative_##h##3, \
+    n_GoNative_##h##4, n_GoNative_##h##5, n_GoNative_##h##6, n_GoNative_##h##7, \
+    n_GoNative_##h##8, n_GoNative_##h##9, n_GoNative_##h##A, n_GoNative_##h##B, \
+    n_GoNative_##h##C, n_GoNative_##h##D, n_GoNative_##h##E, n_GoNative_##h##F,
+
+// clang-format off
+GO_NATIVES(0) GO_NATIVES(1) GO_NATIVES(2) GO_NATIVES(3)
+GO_NATIVES(4) GO_NATIVES(5) GO_NATIVES(6) GO_NATIVES(7)
+GO_NATIVES(8) GO_NATIVES(9) GO_NATIVES(A) GO_NATIVES(B)
+GO_NATIVES(C) GO_NATIVES(D) GO_NATIVES(E) GO_NATIVES(F)
+
+static AMX_NATIVE go_natives[SAMPGO_MAX_NATIVES] = {
+    GO_NATIVE_REFS(0) GO_NATIVE_REFS(1) GO_NATIVE_REFS(2) GO_NATIVE_REFS(3)
+    GO_NATIVE_REFS(4) GO_NATIVE_REFS(5) GO_NATIVE_REFS(6) GO_NATIVE_REFS(7)
+    GO_NATIVE_REFS(8) GO_NATIVE_REFS(9) GO_NATIVE_REFS(A) GO_NATIVE_REFS(B)
+    GO_NATIVE_REFS(C) GO_NATIVE_REFS(D) GO_NATIVE_REFS(E) GO_NATIVE_REFS(F)
+};
+// clang-format on
 
-    sampgdk_logprintf("(C) sampgo: Received event name (%s) with format (%s) and retval (%i)", event, format, retval);
+AMX_NATIVE goNative(int index)
+{
+    if (index < 0 || index >= SAMPGO_MAX_NATIVES) {
+        return NULL;
+    }
+    return go_natives[index];
+}
 
-    free(event);
-    free(format);
-    return retval;
+// GoInt32: sampgo_CallEvent(const event[32], const format[], {Float,_}:...);
+cell AMX_NATIVE_CALL n_CallEvent(AMX* amx, cell* params)
+{
+    return call_event(amx, params, 3, NULL, 0);
+}
+
+// sampgo_CallEventStr(const event[32], result[], len = sizeof result, const format[], {Float,_}:...);
+cell AMX_NATIVE_CALL n_CallEventStr(AMX* amx, cell* params)
+{
+    cell *dest  = NULL;
+
+    if (amx_GetAddr(amx, params[2], &dest) != AMX_ERR_NONE || params[3] <= 0) {
+        sampgdk_logprintf("(C) sampgo: Invalid result buffer passed to n_CallEventStr");
+        return false;
+    }
+
+    return call_event(amx, params, 5, dest, params[3]);
 }
 
 /**
@@ -617,6 +689,62 @@ PLUGIN_EXPORT bool PLUGIN_CALL OnPlayerRequestDownload(int playerid, int type, i
     return true;
 }
 
+// Names of the publics hooked with OnPublic, so the others never reach Go.
+static char** hooked_publics = NULL;
+static int hooked_publics_count = 0;
+
+static bool is_public_hooked(const char* name)
+{
+    for (int i = 0; i < hooked_publics_count; i++) {
+        if (!strcmp(hooked_publics[i], name)) {
+            return true;
+        }
+    }
+    return false;
+}
+
+void hookPublic(char* name)
+{
+    if (is_public_hooked(name)) {
+        return;
+    }
+
+    char** publics = realloc(hooked_publics, sizeof(char*) * (hooked_publics_count + 1));
+    if (publics == NULL) {
+        return;
+    }
+    hooked_publics = publics;
+    hooked_publics[hooked_publics_count] = malloc(strlen(name) + 1);
+    strcpy(hooked_publics[hooked_publics_count++], name);
+}
+
+void unhookPublic(char* name)
+{
+    for (int i = 0; i < hooked_publics_count; i++) {
+        if (!strcmp(hooked_publics[i], name)) {
+            free(hooked_publics[i]);
+            hooked_publics[i] = hooked_publics[--hooked_publics_count];
+            return;
+        }
+    }
+}
+
+/**
+ * \ingroup callbacks
+ * Catch-all hook for every public run on the game mode, including callbacks
+ * raised by other plugins. Setting stop keeps the public from being run.
+ *
+ * The bundled sampgdk only calls this plugin's own callbacks, such as
+ * OnPlayerConnect above, when the hook returns false.
+ */
+PLUGIN_EXPORT bool PLUGIN_CALL OnPublicCall2(AMX* amx, const char* name, cell* params, cell* retval, bool* stop)
+{
+    if (is_public_hooked(name)) {
+        *stop = onPublicCall(amx, name, params, retval);
+    }
+    return false;
+}
+
 PLUGIN_EXPORT unsigned int PLUGIN_CALL Supports()
 {
     return sampgdk_Supports() | SUPPORTS_PROCESS_TICK | SUPPORTS_AMX_NATIVES;
@@ -624,6 +752,11 @@ PLUGIN_EXPORT unsigned int PLUGIN_CALL Supports()
 
 PLUGIN_EXPORT bool PLUGIN_CALL Load(void** ppData)
 {
+#ifdef _WIN32
+    main_thread = GetCurrentThreadId();
+#else
+    main_thread = pthread_self();
+#endif
     sampgdk_Load(ppData, 0);
     return true;
 }
@@ -640,16 +773,33 @@ PLUGIN_EXPORT void PLUGIN_CALL ProcessTick()
 }
 
 PLUGIN_EXPORT int PLUGIN_CALL AmxLoad(AMX *amx) {
-    return amx_Register(amx, native_list, -1);
+    int err = amx_Register(amx, native_list, -1);
+    onAmxLoad(amx);
+    return err;
 }
 
 PLUGIN_EXPORT int PLUGIN_CALL AmxUnload(AMX *amx) {
+    onAmxUnload(amx);
     return AMX_ERR_NONE;
 }
 
+bool amxHasMain(AMX* amx)
+{
+    return ((AMX_HEADER*)amx->base)->cip >= 0;
+}
+
+bool isMainThread()
+{
+#ifdef _WIN32
+    return GetCurrentThreadId() == main_thread;
+#else
+    return pthread_equal(pthread_self(), main_thread);
+#endif
+}
+
 void goLogprintf(char* text)
 {
-    sampgdk_logprintf((const char*)text);
+    sampgdk_logprintf("%s", (const char*)text);
 }
 
 char* constToNonConst(const char* text)
@@ -103,9 +103,32 @@ extern bool onPlayerWeaponShot(int playerid, int weaponid, int hittype, int hiti
 extern bool onPlayerRequestDownload(int playerid, int type, int crc);
 
 extern void onTick();
+
+extern void onAmxLoad(AMX* amx);
+extern void onAmxUnload(AMX* amx);
+
+extern cell callNative(int index, AMX* amx, cell* params);
+
+extern bool onPublicCall(AMX* amx, const char* name, cell* params, cell* retval);
 #endif
 
+// The number of natives that can be registered from Go.
+#define SAMPGO_MAX_NATIVES 256
+
 cell n_CallEvent(AMX* amx, cell* params);
+cell n_CallEventStr(AMX* amx, cell* params);
+
+AMX_NATIVE goNative(int index);
+
+// Whether the script has a main function, which only game modes have.
+bool amxHasMain(AMX* amx);
+
+// Whether the caller runs on the server thread, which loaded the plugin.
+bool isMainThread();
+
+// Add and remove the publics passed on to onPublicCall.
+void hookPublic(char* name);
+void unhookPublic(char* name);
 
 // All of the natives we want to export.
 extern void goLogprintf(char* text);
@@ -0,0 +1,380 @@
+package sampgo
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is the severity of a log entry.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	}
+	return "LEVEL(" + strconv.Itoa(int(l)) + ")"
+}
+
+// Entry is a single log entry handed to the sinks.
+type Entry struct {
+	Time    time.Time
+	Level   Level
+	Logger  string
+	Message string
+	// Fields holds alternating keys and values.
+	Fields []interface{}
+}
+
+// String formats the entry without its time, such as
+//
+//	[WARN] bank: Deposit refused player=3 amount=-5
+//
+// Values spanning several lines, such as stack traces, follow on the next lines.
+func (e Entry) String() string {
+	var b, extra strings.Builder
+
+	b.WriteRune('[')
+	b.WriteString(e.Level.String())
+	b.WriteString("] ")
+	if e.Logger != "" {
+		b.WriteString(e.Logger)
+		b.WriteString(": ")
+	}
+	b.WriteString(e.Message)
+
+	for i := 0; i < len(e.Fields); i += 2 {
+		key := fmt.Sprint(e.Fields[i])
+		value := "(missing)"
+		if i+1 < len(e.Fields) {
+			value = fmt.Sprint(e.Fields[i+1])
+		}
+
+		if strings.Contains(value, "\n") {
+			extra.WriteRune('\n')
+			extra.WriteString(strings.TrimRight(value, "\n"))
+			continue
+		}
+		if value == "" || strings.ContainsAny(value, " \t\"=") {
+			value = strconv.Quote(value)
+		}
+
+		b.WriteRune(' ')
+		b.WriteString(key)
+		b.WriteRune('=')
+		b.WriteString(value)
+	}
+
+	return b.String() + extra.String()
+}
+
+// Sink writes log entries somewhere, such as the server log or a file.
+type Sink interface {
+	Write(entry Entry) error
+}
+
+// Logger writes levelled log entries with key-value fields to the sinks set
+// with SetLogSinks, the server log by default.
+type Logger struct {
+	name   string
+	fields []interface{}
+}
+
+var logConfig = struct {
+	sync.Mutex
+	level  Level
+	levels map[string]Level
+	sinks  []Sink
+}{
+	level:  LevelInfo,
+	levels: make(map[string]Level),
+	sinks:  []Sink{ServerSink()},
+}
+
+// logger is used for sampgo's own diagnostics.
+var logger = NewLogger("sampgo")
+
+// NewLogger returns a logger whose entries are prefixed with name, usually
+// the name of the package using it.
+func NewLogger(name string) *Logger {
+	return &Logger{name: name}
+}
+
+// With returns a logger adding keyvals, alternating keys and values, to every
+// entry.
+func (l *Logger) With(keyvals ...interface{}) *Logger {
+	fields := make([]interface{}, 0, len(l.fields)+len(keyvals))
+	fields = append(fields, l.fields...)
+	fields = append(fields, keyvals...)
+	return &Logger{name: l.name, fields: fields}
+}
+
+// Debug logs msg with keyvals at LevelDebug.
+func (l *Logger) Debug(msg string, keyvals ...interface{}) {
+	l.log(LevelDebug, msg, keyvals)
+}
+
+// Info logs msg with keyvals at LevelInfo.
+func (l *Logger) Info(msg string, keyvals ...interface{}) {
+	l.log(LevelInfo, msg, keyvals)
+}
+
+// Warn logs msg with keyvals at LevelWarn.
+func (l *Logger) Warn(msg string, keyvals ...interface{}) {
+	l.log(LevelWarn, msg, keyvals)
+}
+
+// Error logs msg with keyvals at LevelError.
+func (l *Logger) Error(msg string, keyvals ...interface{}) {
+	l.log(LevelError, msg, keyvals)
+}
+
+// Enabled reports whether entries of level are written, to skip building
+// expensive fields.
+func (l *Logger) Enabled(level Level) bool {
+	logConfig.Lock()
+	defer logConfig.Unlock()
+
+	return level >= l.minLevel()
+}
+
+func (l *Logger) minLevel() Level {
+	if level, ok := logConfig.levels[l.name]; ok {
+		return level
+	}
+	return logConfig.level
+}
+
+func (l *Logger) log(level Level, msg string, keyvals []interface{}) {
+	// The sinks are written without holding the lock, so a slow sink does not
+	// block loggers on other goroutines.
+	logConfig.Lock()
+	if level < l.minLevel() {
+		logConfig.Unlock()
+		return
+	}
+	sinks := logConfig.sinks
+	logConfig.Unlock()
+
+	entry := Entry{Time: time.Now(), Level: level, Logger: l.name, Message: msg, Fields: l.fields}
+	if len(keyvals) > 0 {
+		entry.Fields = append(append([]interface{}(nil), l.fields...), keyvals...)
+	}
+
+	for _, sink := range sinks {
+		if err := sink.Write(entry); err != nil {
+			_ = serverSink{}.Write(Entry{Time: entry.Time, Level: LevelError, Logger: "sampgo", Message: "Log sink failed", Fields: []interface{}{"error", err}})
+		}
+	}
+}
+
+// SetLogLevel sets the minimum level written by every logger without a level
+// of its own. The default is LevelInfo.
+func SetLogLevel(level Level) {
+	logConfig.Lock()
+	defer logConfig.Unlock()
+
+	logConfig.level = level
+}
+
+// SetLoggerLevel sets the minimum level written by the loggers called name,
+// such as "sampgo" for sampgo's own diagnostics.
+func SetLoggerLevel(name string, level Level) {
+	logConfig.Lock()
+	defer logConfig.Unlock()
+
+	logConfig.levels[name] = level
+}
+
+// SetLogSinks replaces the sinks every logger writes to.
+func SetLogSinks(sinks ...Sink) {
+	logConfig.Lock()
+	defer logConfig.Unlock()
+
+	logConfig.sinks = append([]Sink(nil), sinks...)
+}
+
+// AddLogSink adds a sink every logger writes to.
+func AddLogSink(sink Sink) {
+	logConfig.Lock()
+	defer logConfig.Unlock()
+
+	logConfig.sinks = append(logConfig.sinks, sink)
+}
+
+type serverSink struct{}
+
+// ServerSink returns a sink writing to the server log, which adds its own
+// timestamps. Entries logged off the server thread are written with
+// RunOnMainThread during the next tick.
+func ServerSink() Sink {
+	return serverSink{}
+}
+
+func (serverSink) Write(entry Entry) error {
+	lines := strings.Split(entry.String(), "\n")
+	if !onMainThread() {
+		return RunOnMainThread(func() {
+			printLines(lines)
+		})
+	}
+	return printLines(lines)
+}
+
+func printLines(lines []string) error {
+	for _, line := range lines {
+		if err := Print(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type writerSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// WriterSink returns a sink writing timestamped entries to w. Entries logged
+// from several goroutines are written one at a time.
+func WriterSink(w io.Writer) Sink {
+	return &writerSink{w: w}
+}
+
+// StdoutSink returns a sink writing timestamped entries to stdout.
+func StdoutSink() Sink {
+	return WriterSink(os.Stdout)
+}
+
+func (s *writerSink) Write(entry Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err := io.WriteString(s.w, formatTimed(entry))
+	return err
+}
+
+func formatTimed(entry Entry) string {
+	return entry.Time.Format("2006-01-02 15:04:05.000") + " " + entry.String() + "\n"
+}
+
+// FileSink writes timestamped entries to a file, rotating it once it grows
+// past its maximum size.
+type FileSink struct {
+	mu      sync.Mutex
+	path    string
+	maxSize int64
+	backups int
+
+	file *os.File
+	size int64
+}
+
+// NewFileSink opens path for appending. Once the file grows past maxSize
+// bytes it is renamed to path.1, path.1 to path.2 and so on, keeping at most
+// backups old files. A maxSize of 0 or less never rotates.
+func NewFileSink(path string, maxSize int64, backups int) (*FileSink, error) {
+	s := &FileSink{path: path, maxSize: maxSize, backups: backups}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	s.file, s.size = file, info.Size()
+	return nil
+}
+
+func (s *FileSink) Write(entry Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return fmt.Errorf("log file %s is closed", s.path)
+	}
+
+	var rotateErr error
+	line := formatTimed(entry)
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if rotateErr = s.rotate(); s.file == nil {
+			return rotateErr
+		}
+	}
+
+	n, err := s.file.WriteString(line)
+	s.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return rotateErr
+}
+
+func (s *FileSink) rotate() error {
+	err := s.file.Close()
+	s.file = nil
+	if err == nil {
+		err = s.shift()
+	}
+
+	// Reopen the path even if rotating failed, so later entries are not dropped.
+	if openErr := s.open(); openErr != nil {
+		return openErr
+	}
+	return err
+}
+
+// shift renames path to path.1, path.1 to path.2 and so on, or removes path
+// if no backups are kept.
+func (s *FileSink) shift() error {
+	if s.backups < 1 {
+		return os.Remove(s.path)
+	}
+
+	_ = os.Remove(fmt.Sprintf("%s.%d", s.path, s.backups))
+	for i := s.backups - 1; i >= 1; i-- {
+		_ = os.Rename(fmt.Sprintf("%s.%d", s.path, i), fmt.Sprintf("%s.%d", s.path, i+1))
+	}
+	return os.Rename(s.path, s.path+".1")
+}
+
+// Close closes the log file. Remove the sink with SetLogSinks first.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
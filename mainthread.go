@@ -0,0 +1,150 @@
+package sampgo
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// ErrMainThreadQueueFull is returned when work is queued faster than the
+// server thread drains it.
+var ErrMainThreadQueueFull = errors.New("main thread queue is full")
+
+type mainThreadQueue struct {
+	mu     sync.Mutex
+	jobs   []func()
+	limit  int
+	budget time.Duration
+}
+
+var mainThread = mainThreadQueue{
+	limit:  1024,
+	budget: 5 * time.Millisecond,
+}
+
+// RunOnMainThread queues fn to be called on the server thread during the next
+// tick, so goroutines can call natives without racing the server. It returns
+// ErrMainThreadQueueFull instead of blocking when the queue is full.
+func RunOnMainThread(fn func()) error {
+	mainThread.mu.Lock()
+	defer mainThread.mu.Unlock()
+
+	if len(mainThread.jobs) >= mainThread.limit {
+		return ErrMainThreadQueueFull
+	}
+	mainThread.jobs = append(mainThread.jobs, fn)
+
+	return nil
+}
+
+// RunOnMainThreadWait queues fn like RunOnMainThread and blocks until it has
+// been called, returning its result. A panic in fn is returned as an error
+// and reported through the error policy. Called from the server thread itself,
+// where waiting would block the server forever, fn is called right away.
+func RunOnMainThreadWait(fn func() interface{}) (interface{}, error) {
+	type result struct {
+		value interface{}
+		err   error
+	}
+	done := make(chan result, 1)
+
+	job := func() {
+		defer func() {
+			if r := recover(); r != nil {
+				err := fmt.Errorf("panic: %v", r)
+				ReportFailure("Function queued with RunOnMainThreadWait", 1, nil, err, debug.Stack())
+				done <- result{err: err}
+			}
+		}()
+		done <- result{value: fn()}
+	}
+
+	if onMainThread() {
+		job()
+	} else if err := RunOnMainThread(job); err != nil {
+		return nil, err
+	}
+
+	res := <-done
+	return res.value, res.err
+}
+
+// SetMainThreadQueueSize sets how many functions may wait for the server
+// thread at once. The default is 1024.
+func SetMainThreadQueueSize(size int) {
+	mainThread.mu.Lock()
+	defer mainThread.mu.Unlock()
+
+	if size < 1 {
+		size = 1
+	}
+	mainThread.limit = size
+}
+
+// SetMainThreadBudget sets how long queued functions may run per server tick,
+// anything left over is run during the next tick. At least one function is run
+// every tick. A budget of 0 or less runs the functions queued when the tick
+// starts, functions they queue themselves wait for the next tick. The default
+// is 5ms.
+func SetMainThreadBudget(budget time.Duration) {
+	mainThread.mu.Lock()
+	defer mainThread.mu.Unlock()
+
+	mainThread.budget = budget
+}
+
+func (q *mainThreadQueue) pop() (func(), time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.jobs) == 0 {
+		return nil, q.budget
+	}
+
+	fn := q.jobs[0]
+	q.jobs[0] = nil
+	q.jobs = q.jobs[1:]
+	if len(q.jobs) == 0 {
+		q.jobs = nil
+	}
+
+	return fn, q.budget
+}
+
+// drain runs queued functions until the queue is empty or the budget is spent.
+// Without a time budget, it runs at most as many functions as were queued when
+// it started, so functions queueing themselves again can not stall the tick.
+func (q *mainThreadQueue) drain() {
+	start := time.Now()
+
+	q.mu.Lock()
+	queued := len(q.jobs)
+	q.mu.Unlock()
+
+	for ran := 1; ; ran++ {
+		fn, budget := q.pop()
+		if fn == nil {
+			return
+		}
+		runMainThreadJob(fn)
+
+		if budget > 0 && time.Since(start) >= budget {
+			return
+		}
+		if budget <= 0 && ran >= queued {
+			return
+		}
+	}
+}
+
+func runMainThreadJob(fn func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			ReportFailure("Function queued with RunOnMainThread", 1, nil, fmt.Errorf("panic: %v", r), debug.Stack())
+		}
+	}()
+
+	fn()
+}
@@ -0,0 +1,225 @@
+package sampgo
+
+/*
+#cgo windows CFLAGS: -I./lib -I./lib/amx -Wno-attributes -Wno-implicit-function-declaration
+#cgo windows CFLAGS: -DHAVE_INTTYPES_H -DHAVE_MALLOC_H -DHAVE_STDINT_H -DWIN32
+#cgo windows LDFLAGS: -Wl,--subsystem,windows,--kill-at
+
+#cgo linux CFLAGS: -I./lib -I./lib/amx -Wno-attributes -Wno-implicit-function-declaration
+#cgo linux CFLAGS: -DHAVE_INTTYPES_H -DHAVE_MALLOC_H -DHAVE_STDINT_H -DLINUX -D_GNU_SOURCE
+#cgo linux LDFLAGS: -ldl
+
+#ifndef GOLANG_APP
+#define GOLANG_APP
+
+#include "main.h"
+
+#endif
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+)
+
+// ErrPubVarNotFound is returned when a script has no public variable of the
+// given name.
+var ErrPubVarNotFound = errors.New("public variable not found")
+
+// PubVars returns the names of the script's public variables.
+func (a *AMX) PubVars() ([]string, error) {
+	if err := a.checkLoaded(); err != nil {
+		return nil, err
+	}
+
+	var count C.int
+	if err := C.amx_NumPubVars(a.amx, &count); err != C.AMX_ERR_NONE {
+		return nil, fmt.Errorf("AMX error %d", int(err))
+	}
+
+	name := (*C.char)(C.malloc(C.sNAMEMAX + 1))
+	defer C.free(unsafe.Pointer(name))
+
+	names := make([]string, 0, int(count))
+	for i := C.int(0); i < count; i++ {
+		var addr C.cell
+		if err := C.amx_GetPubVar(a.amx, i, name, &addr); err != C.AMX_ERR_NONE {
+			return nil, fmt.Errorf("AMX error %d", int(err))
+		}
+		names = append(names, C.GoString(name))
+	}
+
+	return names, nil
+}
+
+// FindPubVar returns the address of the public variable name, for use with
+// the script's memory methods.
+func (a *AMX) FindPubVar(name string) (Cell, error) {
+	if err := a.checkLoaded(); err != nil {
+		return 0, err
+	}
+
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	var addr C.cell
+	if C.amx_FindPubVar(a.amx, cname, &addr) != C.AMX_ERR_NONE {
+		return 0, fmt.Errorf("%s: %w", name, ErrPubVarNotFound)
+	}
+	return Cell(addr), nil
+}
+
+// PubVar returns the value of the public variable name. Use the Cell's Float
+// and Bool methods for Float and bool variables.
+func (a *AMX) PubVar(name string) (Cell, error) {
+	if err := a.checkLoaded(); err != nil {
+		return 0, err
+	}
+
+	values, err := a.pubVarCells(name, 1)
+	if err != nil {
+		return 0, err
+	}
+	return Cell(values[0]), nil
+}
+
+// SetPubVar sets the public variable name to value.
+func (a *AMX) SetPubVar(name string, value Cell) error {
+	if err := a.checkLoaded(); err != nil {
+		return err
+	}
+
+	values, err := a.pubVarCells(name, 1)
+	if err != nil {
+		return err
+	}
+	values[0] = C.cell(value)
+	return nil
+}
+
+// PubArray returns a copy of the first n cells of the public array name.
+func (a *AMX) PubArray(name string, n int) ([]Cell, error) {
+	if err := a.checkLoaded(); err != nil {
+		return nil, err
+	}
+
+	addr, err := a.FindPubVar(name)
+	if err != nil {
+		return nil, err
+	}
+	return a.ReadCells(addr, n)
+}
+
+// SetPubArray copies values into the public array name, which must have room
+// for all of them.
+func (a *AMX) SetPubArray(name string, values []Cell) error {
+	if err := a.checkLoaded(); err != nil {
+		return err
+	}
+
+	addr, err := a.FindPubVar(name)
+	if err != nil {
+		return err
+	}
+	return a.WriteCells(addr, values)
+}
+
+// PubString returns the packed or unpacked string held by the public array name.
+func (a *AMX) PubString(name string) (string, error) {
+	if err := a.checkLoaded(); err != nil {
+		return "", err
+	}
+
+	addr, err := a.FindPubVar(name)
+	if err != nil {
+		return "", err
+	}
+	return a.String(addr)
+}
+
+// SetPubString writes value to the public array name of size cells, as a
+// packed string if packed is set, truncating it if needed.
+func (a *AMX) SetPubString(name, value string, size int, packed bool) error {
+	if err := a.checkLoaded(); err != nil {
+		return err
+	}
+
+	addr, err := a.FindPubVar(name)
+	if err != nil {
+		return err
+	}
+	if packed {
+		return a.SetPackedString(addr, value, size)
+	}
+	return a.SetString(addr, value, size)
+}
+
+// ReadCells returns a copy of n cells starting at addr.
+func (a *AMX) ReadCells(addr Cell, n int) ([]Cell, error) {
+	if err := a.checkLoaded(); err != nil {
+		return nil, err
+	}
+
+	src, err := a.cellRange(addr, n)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]Cell, n)
+	for i, c := range src {
+		values[i] = Cell(c)
+	}
+	return values, nil
+}
+
+// WriteCells copies values to the memory starting at addr.
+func (a *AMX) WriteCells(addr Cell, values []Cell) error {
+	if err := a.checkLoaded(); err != nil {
+		return err
+	}
+
+	dest, err := a.cellRange(addr, len(values))
+	if err != nil {
+		return err
+	}
+
+	for i, v := range values {
+		dest[i] = C.cell(v)
+	}
+	return nil
+}
+
+// SetPackedString writes value as a packed string to the array of size cells
+// at addr, truncating it if needed.
+func (a *AMX) SetPackedString(addr Cell, value string, size int) error {
+	if err := a.checkLoaded(); err != nil {
+		return err
+	}
+
+	dest, err := a.cellRange(addr, size)
+	if err != nil {
+		return err
+	}
+
+	cstr := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr))
+	C.amx_SetString(&dest[0], cstr, 1, 0, C.size_t(size))
+
+	return nil
+}
+
+// pubVarCells returns a view of the first n cells of the public variable name.
+func (a *AMX) pubVarCells(name string, n int) ([]C.cell, error) {
+	addr, err := a.FindPubVar(name)
+	if err != nil {
+		return nil, err
+	}
+	return a.cellRange(addr, n)
+}
+
+// cellRange returns a view of n cells starting at addr, checking that all of
+// them lie inside the script's memory.
+func (a *AMX) cellRange(addr Cell, n int) ([]C.cell, error) {
+	return amxCells(a.amx, C.cell(addr), n)
+}
@@ -13,14 +13,20 @@ func (o *Object) GetID() int {
 	return o.ID
 }
 
-func NewObject(modelid int, x, y, z, rX, rY, rZ, drawDistance float32) (o *Object) {
-	o = new(Object)
+func NewObject(modelid int, x, y, z, rX, rY, rZ, drawDistance float32) (*Object, error) {
+	o := new(Object)
 	o.ID = CreateObject(modelid, x, y, z, rX, rY, rZ, drawDistance)
-	return
+	if o.ID == InvalidObjectId {
+		return o, fmt.Errorf("can not create object: %w", ErrLimitReached)
+	}
+	return o, nil
 }
 
-func (o *Object) Destroy() {
-	DestroyObject(o.ID)
+func (o *Object) Destroy() error {
+	if !DestroyObject(o.ID) {
+		return objectError(o.ID, ErrInvalidObject)
+	}
+	return nil
 }
 
 func (o *Object) IsValid() bool {
@@ -49,14 +55,14 @@ func (o *Object) SetRot(rx, ry, rz float32) {
 
 func (o *Object) GetPos() (x, y, z float32, err error) {
 	if !GetObjectPos(o.ID, &x, &y, &z) {
-		err = fmt.Errorf("invalid object")
+		err = objectError(o.ID, ErrInvalidObject)
 	}
 	return
 }
 
 func (o *Object) GetRot() (rx, ry, rz float32, err error) {
 	if !GetObjectRot(o.ID, &rx, &ry, &rz) {
-		err = fmt.Errorf("invalid object")
+		err = objectError(o.ID, ErrInvalidObject)
 	}
 	return
 }
@@ -76,8 +82,11 @@ func NewPlayerObject(modelid int, x, y, z, rX, rY, rZ, drawDistance float32) (o
 	return
 }
 
-func (o *PlayerObject) Destroy() {
-	DestroyPlayerObject(o.player.ID, o.ID)
+func (o *PlayerObject) Destroy() error {
+	if !DestroyPlayerObject(o.player.ID, o.ID) {
+		return objectError(o.ID, ErrInvalidObject)
+	}
+	return nil
 }
 
 func (o *PlayerObject) IsValid() bool {
@@ -106,14 +115,14 @@ func (o *PlayerObject) SetRot(rx, ry, rz float32) {
 
 func (o *PlayerObject) GetPos() (x, y, z float32, err error) {
 	if !GetPlayerObjectPos(o.player.ID, o.ID, &x, &y, &z) {
-		err = fmt.Errorf("invalid object")
+		err = objectError(o.ID, ErrInvalidObject)
 	}
 	return
 }
 
 func (o *PlayerObject) GetRot() (rx, ry, rz float32, err error) {
 	if !GetPlayerObjectRot(o.player.ID, o.ID, &rx, &ry, &rz) {
-		err = fmt.Errorf("invalid object")
+		err = objectError(o.ID, ErrInvalidObject)
 	}
 	return
 }
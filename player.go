@@ -15,122 +15,82 @@ func (p *Player) GetID() int {
 }
 
 // GetName returns the players name.
-func (p *Player) GetName() string {
-	var name string
-	GetPlayerName(p.ID, &name, MaxPlayerName)
-	return name
+func (p *Player) GetName() (name string, err error) {
+	if GetPlayerName(p.ID, &name, MaxPlayerName) == 0 {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
 }
 
-// SetName sets the players name.
+// SetName sets the players name. Names are 1 to 24 characters long and may
+// only contain 0-9, a-z, A-Z and []()$@._=.
 func (p *Player) SetName(name string) error {
-	if len(name) > 24 {
-		return fmt.Errorf("name length above 24 chars")
+	if len(name) < 1 || len(name) > 24 {
+		return playerError(p.ID, fmt.Errorf("%w: %q must be between 1 and 24 characters", ErrInvalidName, name))
+	}
+	for _, r := range name {
+		if !isNameRune(r) {
+			return playerError(p.ID, fmt.Errorf("%w: %q contains %q", ErrInvalidName, name, r))
+		}
 	}
 
-	ret := SetPlayerName(p.ID, name)
-
-	switch ret {
-	case 1:
-		return nil
+	switch SetPlayerName(p.ID, name) {
 	case 0:
-		return fmt.Errorf("player already has that name")
+		if !IsPlayerConnected(p.ID) {
+			return playerError(p.ID, ErrPlayerNotConnected)
+		}
+		return playerError(p.ID, ErrSameName)
 	case -1:
-		return fmt.Errorf("name can not be changed (it's already in use, too long or has invalid characters)")
+		return playerError(p.ID, ErrNameInUse)
 	}
 
 	return nil
 }
 
-// SendMessage allows you to send a player a message.
-func (p *Player) SendMessage(colour int, msg string) error {
-	if len(msg) < 1 {
-		return fmt.Errorf("msg too short")
-	}
-	if len(msg) > 144 {
-		return fmt.Errorf("message too long")
+// isNameRune reports whether r may be used in a player's name.
+func isNameRune(r rune) bool {
+	switch {
+	case r >= '0' && r <= '9', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		return true
 	}
-
-	if !SendClientMessage(p.ID, colour, msg) {
-		return fmt.Errorf("the player is not connected")
+	switch r {
+	case '[', ']', '(', ')', '$', '@', '.', '_', '=':
+		return true
 	}
-	return nil
-}
-
-// GetPos gets the player's current position.
-func (p *Player) GetPos() (float32, float32, float32, error) {
-	var x, y, z float32
-	if !GetPlayerPos(p.ID, &x, &y, &z) {
-		return x, y, z, fmt.Errorf("GetPlayerPos failure (i.e. player not connected)")
-	}
-	return x, y, z, nil
+	return false
 }
 
-// SetPos sets the player's current position.
-func (p *Player) SetPos(x, y, z float32) error {
-	if !SetPlayerPos(p.ID, x, y, z) {
-		return fmt.Errorf("player not found")
+// SendMessage allows you to send a player a message.
+func (p *Player) SendMessage(colour int, msg string) error {
+	if len(msg) < 1 || len(msg) > 144 {
+		return playerError(p.ID, ErrInvalidMessage)
 	}
-	return nil
-}
 
-// Spawn spawns the player.
-func (p *Player) Spawn() error {
-	if !SpawnPlayer(p.ID) {
-		return fmt.Errorf("player was unable to be spawned")
+	if !SendClientMessage(p.ID, colour, msg) {
+		return playerError(p.ID, ErrPlayerNotConnected)
 	}
 	return nil
 }
 
-// Set player spawn info
-func (p *Player) SetSpawnInfo(team, skin int, x, y, z, rotation float32, weapon1, weapon1_ammo, weapon2, weapon2_ammo, weapon3, weapon3_ammo int) {
-	SetSpawnInfo(p.ID, team, skin, x, y, z, rotation, weapon1, weapon1_ammo, weapon2, weapon2_ammo, weapon3, weapon3_ammo)
-}
-
 func (p *Player) ShowDialog(dialogid, style int, caption, info, button1, button2 string) error {
 	if !ShowPlayerDialog(p.ID, dialogid, style, caption, info, button1, button2) {
-		return fmt.Errorf("couldn't show dialog")
+		return playerError(p.ID, ErrPlayerNotConnected)
 	}
 	return nil
 }
 
-func (p *Player) GetFacingAngle() (float32, error) {
-	var a float32
-	if !GetPlayerFacingAngle(p.ID, &a) {
-		return a, fmt.Errorf("invalid player")
-	}
-	return a, nil
-}
-
-func (p *Player) GiveMoney(money int) error {
-	if !GivePlayerMoney(p.ID, money) {
-		return fmt.Errorf("invalid player")
-	}
-	return nil
-}
-
-func (p *Player) GetMoney() int {
-	return GetPlayerMoney(p.ID)
-}
-
-func (p *Player) ResetMoney() error {
-	if !ResetPlayerMoney(p.ID) {
-		return fmt.Errorf("invalid player")
-	}
-	return nil
-}
-
-func (p *Player) IsAdmin() bool {
-	return IsPlayerAdmin(p.ID)
-}
-
+// GetPlayerState returns the players state.
+//
+// Deprecated: use GetState.
 func (p *Player) GetPlayerState() int {
-	return GetPlayerState(p.ID)
+	state, _ := p.GetState()
+	return state
 }
 
 func (p *Player) GetVehicle() (v Vehicle, err error) {
 	v.ID = GetPlayerVehicleID(p.ID)
 	if v.ID == 0 {
-		err = fmt.Errorf("player is not in a vehicle")
+		err = playerError(p.ID, ErrNotInVehicle)
 	}
 	return
 }
@@ -139,80 +99,112 @@ func (p *Player) IsInVehicle(v *Vehicle) bool {
 	return IsPlayerInVehicle(p.ID, v.ID)
 }
 
-func (p *Player) IsInAnyVehicle(v *Vehicle) bool {
+func (p *Player) IsInAnyVehicle() bool {
 	return IsPlayerInAnyVehicle(p.ID)
 }
 
-func (p *Player) ApplyAnimation(animlib, animname string, fDelta float32, loop, lockx, locky, freeze bool, time int, forcesync bool) {
-	ApplyAnimation(p.ID, animlib, animname, fDelta, loop, lockx, locky, freeze, time, forcesync)
-}
-
-func (p *Player) ClearAnimations(forcesync bool) {
-	ClearAnimations(p.ID, forcesync)
-}
-
-func (p *Player) SetSpecialAction(actionid int) error {
-	if !SetPlayerSpecialAction(p.ID, actionid) {
-		return fmt.Errorf("invalid player")
+// Kick kicks the player. The native always succeeds, so connection is checked
+// beforehand.
+func (p *Player) Kick() error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
 	}
-	return nil
-}
-
-func (p *Player) GetSpecialAction() int {
-	return GetPlayerSpecialAction(p.ID)
-}
-
-func (p *Player) SelectTextDraw(hovercolor int) {
-	SelectTextDraw(p.ID, hovercolor)
-}
-
-func (p *Player) CancelSelectTextDraw() {
-	CancelSelectTextDraw(p.ID)
-}
-
-func (p *Player) Kick() {
 	Kick(p.ID)
+	return nil
 }
 
-func (p *Player) Ban() {
+func (p *Player) Ban() error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
 	Ban(p.ID)
+	return nil
 }
 
-func (p *Player) BanEx(reason string) {
+func (p *Player) BanEx(reason string) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
 	BanEx(p.ID, reason)
+	return nil
 }
 
 func (p *Player) GetIP() (ip string, err error) {
 	GetPlayerIp(p.ID, &ip, 16)
 	if ip == "255.255.255.255" {
-		err = fmt.Errorf("invalid/disconnected player")
+		err = playerError(p.ID, ErrPlayerNotConnected)
 	}
 	return
 }
 
-func (p *Player) GetIPPort() (ipPort string) {
-	NetStats_GetIpPort(p.ID, &ipPort, 22)
+func (p *Player) GetIPPort() (ipPort string, err error) {
+	if !NetStats_GetIpPort(p.ID, &ipPort, 22) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
 	return
 }
 
-func (p *Player) GetPing() time.Duration {
-	return time.Duration(GetPlayerPing(p.ID)) * time.Millisecond
+func (p *Player) GetPing() (time.Duration, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return time.Duration(GetPlayerPing(p.ID)) * time.Millisecond, nil
 }
 
-func (p *Player) GetVersion() (version string) {
-	GetPlayerVersion(p.ID, &version, 24)
+func (p *Player) GetVersion() (version string, err error) {
+	if !GetPlayerVersion(p.ID, &version, 24) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
 	return
 }
 
 func (p *Player) GetConnectedTime() (time.Duration, error) {
 	connectedTime := time.Duration(NetStats_GetConnectedTime(p.ID))
 	if connectedTime == 0 {
-		return connectedTime, fmt.Errorf("invalid player")
+		return connectedTime, playerError(p.ID, ErrPlayerNotConnected)
 	}
 
 	return connectedTime * time.Millisecond, nil
 }
 
-func (p *Player) AttachObject(o Object, offsetX, offsetY, offsetZ, rotX, rotY, rotZ float32) {
-	AttachObjectToPlayer(o.ID, p.ID, offsetX, offsetY, offsetZ, rotX, rotY, rotZ)
+func (p *Player) AttachObject(o Object, offsetX, offsetY, offsetZ, rotX, rotY, rotZ float32) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	if !AttachObjectToPlayer(o.ID, p.ID, offsetX, offsetY, offsetZ, rotX, rotY, rotZ) {
+		return objectError(o.ID, ErrInvalidObject)
+	}
+	return nil
+}
+
+// playerID returns the ID of p, or InvalidPlayerId if p is nil, for natives
+// taking an optional player such as the killer in SendDeathMessage.
+func playerID(p *Player) int {
+	if p == nil {
+		return InvalidPlayerId
+	}
+	return p.ID
+}
+
+var (
+	playerGoneHandlers []func(playerID int)
+	playerGoneHooked   bool
+)
+
+// onPlayerGone registers fn to forget a disconnected player, such as the
+// viewers of textdraws and gang zones. All of them share a single handler run
+// after every other playerDisconnect handler.
+func onPlayerGone(fn func(playerID int)) {
+	playerGoneHandlers = append(playerGoneHandlers, fn)
+	if playerGoneHooked {
+		return
+	}
+	playerGoneHooked = true
+
+	_, _ = OnWithPriority("playerDisconnect", PriorityLowest, func(p Player, reason int) bool {
+		for _, fn := range playerGoneHandlers {
+			fn(p.ID)
+		}
+		return true
+	})
 }
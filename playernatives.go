@@ -0,0 +1,1539 @@
+package sampgo
+
+// SetSpawnInfo calls SetSpawnInfo for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetSpawnInfo
+func (p *Player) SetSpawnInfo(team int, skin int, x float32, y float32, z float32, rotation float32, weapon1 int, weapon1_ammo int, weapon2 int, weapon2_ammo int, weapon3 int, weapon3_ammo int) error {
+	if !SetSpawnInfo(p.ID, team, skin, x, y, z, rotation, weapon1, weapon1_ammo, weapon2, weapon2_ammo, weapon3, weapon3_ammo) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// Spawn calls SpawnPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SpawnPlayer
+func (p *Player) Spawn() error {
+	if !SpawnPlayer(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetPos calls SetPlayerPos for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerPos
+func (p *Player) SetPos(x float32, y float32, z float32) error {
+	if !SetPlayerPos(p.ID, x, y, z) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetPosFindZ calls SetPlayerPosFindZ for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerPosFindZ
+func (p *Player) SetPosFindZ(x float32, y float32, z float32) error {
+	if !SetPlayerPosFindZ(p.ID, x, y, z) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetPos calls GetPlayerPos for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerPos
+func (p *Player) GetPos() (x float32, y float32, z float32, err error) {
+	if !GetPlayerPos(p.ID, &x, &y, &z) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// SetFacingAngle calls SetPlayerFacingAngle for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerFacingAngle
+func (p *Player) SetFacingAngle(angle float32) error {
+	if !SetPlayerFacingAngle(p.ID, angle) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetFacingAngle calls GetPlayerFacingAngle for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerFacingAngle
+func (p *Player) GetFacingAngle() (angle float32, err error) {
+	if !GetPlayerFacingAngle(p.ID, &angle) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// IsInRangeOfPoint calls IsPlayerInRangeOfPoint for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/IsPlayerInRangeOfPoint
+func (p *Player) IsInRangeOfPoint(range_ float32, x float32, y float32, z float32) bool {
+	return IsPlayerInRangeOfPoint(p.ID, range_, x, y, z)
+}
+
+// GetDistanceFromPoint calls GetPlayerDistanceFromPoint for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerDistanceFromPoint
+func (p *Player) GetDistanceFromPoint(x float32, y float32, z float32) (float32, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerDistanceFromPoint(p.ID, x, y, z), nil
+}
+
+// IsStreamedIn calls IsPlayerStreamedIn for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/IsPlayerStreamedIn
+func (p *Player) IsStreamedIn(forplayer *Player) bool {
+	return IsPlayerStreamedIn(p.ID, playerID(forplayer))
+}
+
+// SetInterior calls SetPlayerInterior for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerInterior
+func (p *Player) SetInterior(interiorid int) error {
+	if !SetPlayerInterior(p.ID, interiorid) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetInterior calls GetPlayerInterior for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerInterior
+func (p *Player) GetInterior() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerInterior(p.ID), nil
+}
+
+// SetHealth calls SetPlayerHealth for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerHealth
+func (p *Player) SetHealth(health float32) error {
+	if !SetPlayerHealth(p.ID, health) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetHealth calls GetPlayerHealth for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerHealth
+func (p *Player) GetHealth() (health float32, err error) {
+	if !GetPlayerHealth(p.ID, &health) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// SetArmour calls SetPlayerArmour for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerArmour
+func (p *Player) SetArmour(armour float32) error {
+	if !SetPlayerArmour(p.ID, armour) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetArmour calls GetPlayerArmour for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerArmour
+func (p *Player) GetArmour() (armour float32, err error) {
+	if !GetPlayerArmour(p.ID, &armour) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// SetAmmo calls SetPlayerAmmo for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerAmmo
+func (p *Player) SetAmmo(weaponid int, ammo int) error {
+	if !SetPlayerAmmo(p.ID, weaponid, ammo) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetAmmo calls GetPlayerAmmo for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerAmmo
+func (p *Player) GetAmmo() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerAmmo(p.ID), nil
+}
+
+// GetWeaponState calls GetPlayerWeaponState for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerWeaponState
+func (p *Player) GetWeaponState() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerWeaponState(p.ID), nil
+}
+
+// GetTargetPlayer calls GetPlayerTargetPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerTargetPlayer
+func (p *Player) GetTargetPlayer() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerTargetPlayer(p.ID), nil
+}
+
+// GetTargetActor calls GetPlayerTargetActor for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerTargetActor
+func (p *Player) GetTargetActor() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerTargetActor(p.ID), nil
+}
+
+// SetTeam calls SetPlayerTeam for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerTeam
+func (p *Player) SetTeam(teamid int) error {
+	if !SetPlayerTeam(p.ID, teamid) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetTeam calls GetPlayerTeam for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerTeam
+func (p *Player) GetTeam() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerTeam(p.ID), nil
+}
+
+// SetScore calls SetPlayerScore for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerScore
+func (p *Player) SetScore(score int) error {
+	if !SetPlayerScore(p.ID, score) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetScore calls GetPlayerScore for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerScore
+func (p *Player) GetScore() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerScore(p.ID), nil
+}
+
+// GetDrunkLevel calls GetPlayerDrunkLevel for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerDrunkLevel
+func (p *Player) GetDrunkLevel() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerDrunkLevel(p.ID), nil
+}
+
+// SetDrunkLevel calls SetPlayerDrunkLevel for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerDrunkLevel
+func (p *Player) SetDrunkLevel(level int) error {
+	if !SetPlayerDrunkLevel(p.ID, level) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetColor calls SetPlayerColor for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerColor
+func (p *Player) SetColor(color int) error {
+	if !SetPlayerColor(p.ID, color) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetColor calls GetPlayerColor for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerColor
+func (p *Player) GetColor() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerColor(p.ID), nil
+}
+
+// SetSkin calls SetPlayerSkin for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerSkin
+func (p *Player) SetSkin(skinid int) error {
+	if !SetPlayerSkin(p.ID, skinid) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetSkin calls GetPlayerSkin for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerSkin
+func (p *Player) GetSkin() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerSkin(p.ID), nil
+}
+
+// GiveWeapon calls GivePlayerWeapon for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GivePlayerWeapon
+func (p *Player) GiveWeapon(weaponid int, ammo int) error {
+	if !GivePlayerWeapon(p.ID, weaponid, ammo) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// ResetWeapons calls ResetPlayerWeapons for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/ResetPlayerWeapons
+func (p *Player) ResetWeapons() error {
+	if !ResetPlayerWeapons(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetArmedWeapon calls SetPlayerArmedWeapon for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerArmedWeapon
+func (p *Player) SetArmedWeapon(weaponid int) error {
+	if !SetPlayerArmedWeapon(p.ID, weaponid) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetWeaponData calls GetPlayerWeaponData for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerWeaponData
+func (p *Player) GetWeaponData(slot int) (weapon int, ammo int, err error) {
+	if !GetPlayerWeaponData(p.ID, slot, &weapon, &ammo) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// GiveMoney calls GivePlayerMoney for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GivePlayerMoney
+func (p *Player) GiveMoney(money int) error {
+	if !GivePlayerMoney(p.ID, money) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// ResetMoney calls ResetPlayerMoney for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/ResetPlayerMoney
+func (p *Player) ResetMoney() error {
+	if !ResetPlayerMoney(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetMoney calls GetPlayerMoney for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerMoney
+func (p *Player) GetMoney() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerMoney(p.ID), nil
+}
+
+// GetState calls GetPlayerState for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerState
+func (p *Player) GetState() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerState(p.ID), nil
+}
+
+// GetWeapon calls GetPlayerWeapon for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerWeapon
+func (p *Player) GetWeapon() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerWeapon(p.ID), nil
+}
+
+// GetKeys calls GetPlayerKeys for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerKeys
+func (p *Player) GetKeys() (keys int, updown int, leftright int, err error) {
+	if !GetPlayerKeys(p.ID, &keys, &updown, &leftright) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// SetTime calls SetPlayerTime for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerTime
+func (p *Player) SetTime(hour int, minute int) error {
+	if !SetPlayerTime(p.ID, hour, minute) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetTime calls GetPlayerTime for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerTime
+func (p *Player) GetTime() (hour int, minute int, err error) {
+	if !GetPlayerTime(p.ID, &hour, &minute) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// ToggleClock calls TogglePlayerClock for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/TogglePlayerClock
+func (p *Player) ToggleClock(toggle bool) error {
+	if !TogglePlayerClock(p.ID, toggle) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetWeather calls SetPlayerWeather for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerWeather
+func (p *Player) SetWeather(weather int) error {
+	if !SetPlayerWeather(p.ID, weather) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// ForceClassSelection calls ForceClassSelection for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/ForceClassSelection
+func (p *Player) ForceClassSelection() error {
+	if !ForceClassSelection(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetWantedLevel calls SetPlayerWantedLevel for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerWantedLevel
+func (p *Player) SetWantedLevel(level int) error {
+	if !SetPlayerWantedLevel(p.ID, level) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetWantedLevel calls GetPlayerWantedLevel for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerWantedLevel
+func (p *Player) GetWantedLevel() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerWantedLevel(p.ID), nil
+}
+
+// SetFightingStyle calls SetPlayerFightingStyle for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerFightingStyle
+func (p *Player) SetFightingStyle(style int) error {
+	if !SetPlayerFightingStyle(p.ID, style) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetFightingStyle calls GetPlayerFightingStyle for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerFightingStyle
+func (p *Player) GetFightingStyle() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerFightingStyle(p.ID), nil
+}
+
+// SetVelocity calls SetPlayerVelocity for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerVelocity
+func (p *Player) SetVelocity(x float32, y float32, z float32) error {
+	if !SetPlayerVelocity(p.ID, x, y, z) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetVelocity calls GetPlayerVelocity for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerVelocity
+func (p *Player) GetVelocity() (x float32, y float32, z float32, err error) {
+	if !GetPlayerVelocity(p.ID, &x, &y, &z) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// PlayCrimeReport calls PlayCrimeReportForPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/PlayCrimeReportForPlayer
+func (p *Player) PlayCrimeReport(suspectid int, crime int) error {
+	if !PlayCrimeReportForPlayer(p.ID, suspectid, crime) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// PlayAudioStream calls PlayAudioStreamForPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/PlayAudioStreamForPlayer
+func (p *Player) PlayAudioStream(url string, posX float32, posY float32, posZ float32, distance float32, usepos bool) error {
+	if !PlayAudioStreamForPlayer(p.ID, url, posX, posY, posZ, distance, usepos) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// StopAudioStream calls StopAudioStreamForPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/StopAudioStreamForPlayer
+func (p *Player) StopAudioStream() error {
+	if !StopAudioStreamForPlayer(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetShopName calls SetPlayerShopName for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerShopName
+func (p *Player) SetShopName(shopname string) error {
+	if !SetPlayerShopName(p.ID, shopname) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetSkillLevel calls SetPlayerSkillLevel for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerSkillLevel
+func (p *Player) SetSkillLevel(skill int, level int) error {
+	if !SetPlayerSkillLevel(p.ID, skill, level) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetSurfingVehicleID calls GetPlayerSurfingVehicleID for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerSurfingVehicleID
+func (p *Player) GetSurfingVehicleID() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerSurfingVehicleID(p.ID), nil
+}
+
+// GetSurfingObjectID calls GetPlayerSurfingObjectID for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerSurfingObjectID
+func (p *Player) GetSurfingObjectID() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerSurfingObjectID(p.ID), nil
+}
+
+// RemoveBuilding calls RemoveBuildingForPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/RemoveBuildingForPlayer
+func (p *Player) RemoveBuilding(modelid int, fX float32, fY float32, fZ float32, fRadius float32) error {
+	if !RemoveBuildingForPlayer(p.ID, modelid, fX, fY, fZ, fRadius) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetLastShotVectors calls GetPlayerLastShotVectors for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerLastShotVectors
+func (p *Player) GetLastShotVectors() (fOriginX float32, fOriginY float32, fOriginZ float32, fHitPosX float32, fHitPosY float32, fHitPosZ float32, err error) {
+	if !GetPlayerLastShotVectors(p.ID, &fOriginX, &fOriginY, &fOriginZ, &fHitPosX, &fHitPosY, &fHitPosZ) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// SetAttachedObject calls SetPlayerAttachedObject for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerAttachedObject
+func (p *Player) SetAttachedObject(index int, modelid int, bone int, fOffsetX float32, fOffsetY float32, fOffsetZ float32, fRotX float32, fRotY float32, fRotZ float32, fScaleX float32, fScaleY float32, fScaleZ float32, materialcolor1 int, materialcolor2 int) error {
+	if !SetPlayerAttachedObject(p.ID, index, modelid, bone, fOffsetX, fOffsetY, fOffsetZ, fRotX, fRotY, fRotZ, fScaleX, fScaleY, fScaleZ, materialcolor1, materialcolor2) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// RemoveAttachedObject calls RemovePlayerAttachedObject for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/RemovePlayerAttachedObject
+func (p *Player) RemoveAttachedObject(index int) error {
+	if !RemovePlayerAttachedObject(p.ID, index) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// IsAttachedObjectSlotUsed calls IsPlayerAttachedObjectSlotUsed for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/IsPlayerAttachedObjectSlotUsed
+func (p *Player) IsAttachedObjectSlotUsed(index int) bool {
+	return IsPlayerAttachedObjectSlotUsed(p.ID, index)
+}
+
+// EditAttachedObject calls EditAttachedObject for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/EditAttachedObject
+func (p *Player) EditAttachedObject(index int) error {
+	if !EditAttachedObject(p.ID, index) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetPVarInt calls SetPVarInt for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPVarInt
+func (p *Player) SetPVarInt(varname string, value int) error {
+	if !SetPVarInt(p.ID, varname, value) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetPVarInt calls GetPVarInt for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPVarInt
+func (p *Player) GetPVarInt(varname string) (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPVarInt(p.ID, varname), nil
+}
+
+// SetPVarString calls SetPVarString for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPVarString
+func (p *Player) SetPVarString(varname string, value string) error {
+	if !SetPVarString(p.ID, varname, value) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetPVarString calls GetPVarString for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPVarString
+func (p *Player) GetPVarString(varname string) (value string, err error) {
+	if !IsPlayerConnected(p.ID) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+		return
+	}
+	if !GetPVarString(p.ID, varname, &value, 1024) {
+		err = playerError(p.ID, ErrPVarNotSet)
+	}
+	return
+}
+
+// SetPVarFloat calls SetPVarFloat for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPVarFloat
+func (p *Player) SetPVarFloat(varname string, value float32) error {
+	if !SetPVarFloat(p.ID, varname, value) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetPVarFloat calls GetPVarFloat for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPVarFloat
+func (p *Player) GetPVarFloat(varname string) (float32, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPVarFloat(p.ID, varname), nil
+}
+
+// DeletePVar calls DeletePVar for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/DeletePVar
+func (p *Player) DeletePVar(varname string) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	if !DeletePVar(p.ID, varname) {
+		return playerError(p.ID, ErrPVarNotSet)
+	}
+	return nil
+}
+
+// GetPVarsUpperIndex calls GetPVarsUpperIndex for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPVarsUpperIndex
+func (p *Player) GetPVarsUpperIndex() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPVarsUpperIndex(p.ID), nil
+}
+
+// GetPVarNameAtIndex calls GetPVarNameAtIndex for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPVarNameAtIndex
+func (p *Player) GetPVarNameAtIndex(index int) (varname string, err error) {
+	if !GetPVarNameAtIndex(p.ID, index, &varname, 1024) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// GetPVarType calls GetPVarType for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPVarType
+func (p *Player) GetPVarType(varname string) (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPVarType(p.ID, varname), nil
+}
+
+// SetChatBubble calls SetPlayerChatBubble for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerChatBubble
+func (p *Player) SetChatBubble(text string, color int, drawdistance float32, expiretime int) error {
+	if !SetPlayerChatBubble(p.ID, text, color, drawdistance, expiretime) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// PutInVehicle calls PutPlayerInVehicle for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/PutPlayerInVehicle
+func (p *Player) PutInVehicle(vehicle *Vehicle, seatid int) error {
+	if !PutPlayerInVehicle(p.ID, vehicleID(vehicle), seatid) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetVehicleSeat calls GetPlayerVehicleSeat for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerVehicleSeat
+func (p *Player) GetVehicleSeat() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerVehicleSeat(p.ID), nil
+}
+
+// RemoveFromVehicle calls RemovePlayerFromVehicle for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/RemovePlayerFromVehicle
+func (p *Player) RemoveFromVehicle() error {
+	if !RemovePlayerFromVehicle(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// ToggleControllable calls TogglePlayerControllable for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/TogglePlayerControllable
+func (p *Player) ToggleControllable(toggle bool) error {
+	if !TogglePlayerControllable(p.ID, toggle) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// PlaySound calls PlayerPlaySound for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/PlayerPlaySound
+func (p *Player) PlaySound(soundid int, x float32, y float32, z float32) error {
+	if !PlayerPlaySound(p.ID, soundid, x, y, z) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// ApplyAnimation calls ApplyAnimation for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/ApplyAnimation
+func (p *Player) ApplyAnimation(animlib string, animname string, fDelta float32, loop bool, lockx bool, locky bool, freeze bool, time int, forcesync bool) error {
+	if !ApplyAnimation(p.ID, animlib, animname, fDelta, loop, lockx, locky, freeze, time, forcesync) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// ClearAnimations calls ClearAnimations for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/ClearAnimations
+func (p *Player) ClearAnimations(forcesync bool) error {
+	if !ClearAnimations(p.ID, forcesync) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetAnimationIndex calls GetPlayerAnimationIndex for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerAnimationIndex
+func (p *Player) GetAnimationIndex() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerAnimationIndex(p.ID), nil
+}
+
+// GetSpecialAction calls GetPlayerSpecialAction for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerSpecialAction
+func (p *Player) GetSpecialAction() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerSpecialAction(p.ID), nil
+}
+
+// SetSpecialAction calls SetPlayerSpecialAction for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerSpecialAction
+func (p *Player) SetSpecialAction(actionid int) error {
+	if !SetPlayerSpecialAction(p.ID, actionid) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// DisableRemoteVehicleCollisions calls DisableRemoteVehicleCollisions for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/DisableRemoteVehicleCollisions
+func (p *Player) DisableRemoteVehicleCollisions(disable bool) error {
+	if !DisableRemoteVehicleCollisions(p.ID, disable) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetCheckpoint calls SetPlayerCheckpoint for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerCheckpoint
+func (p *Player) SetCheckpoint(x float32, y float32, z float32, size float32) error {
+	if !SetPlayerCheckpoint(p.ID, x, y, z, size) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// DisableCheckpoint calls DisablePlayerCheckpoint for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/DisablePlayerCheckpoint
+func (p *Player) DisableCheckpoint() error {
+	if !DisablePlayerCheckpoint(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetRaceCheckpoint calls SetPlayerRaceCheckpoint for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerRaceCheckpoint
+func (p *Player) SetRaceCheckpoint(type_ int, x float32, y float32, z float32, nextx float32, nexty float32, nextz float32, size float32) error {
+	if !SetPlayerRaceCheckpoint(p.ID, type_, x, y, z, nextx, nexty, nextz, size) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// DisableRaceCheckpoint calls DisablePlayerRaceCheckpoint for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/DisablePlayerRaceCheckpoint
+func (p *Player) DisableRaceCheckpoint() error {
+	if !DisablePlayerRaceCheckpoint(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetWorldBounds calls SetPlayerWorldBounds for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerWorldBounds
+func (p *Player) SetWorldBounds(x_max float32, x_min float32, y_max float32, y_min float32) error {
+	if !SetPlayerWorldBounds(p.ID, x_max, x_min, y_max, y_min) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetMarkerForPlayer calls SetPlayerMarkerForPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerMarkerForPlayer
+func (p *Player) SetMarkerForPlayer(showplayer *Player, color int) error {
+	if !SetPlayerMarkerForPlayer(p.ID, playerID(showplayer), color) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// ShowNameTagForPlayer calls ShowPlayerNameTagForPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/ShowPlayerNameTagForPlayer
+func (p *Player) ShowNameTagForPlayer(showplayer *Player, show bool) error {
+	if !ShowPlayerNameTagForPlayer(p.ID, playerID(showplayer), show) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetMapIcon calls SetPlayerMapIcon for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerMapIcon
+func (p *Player) SetMapIcon(iconid int, x float32, y float32, z float32, markertype int, color int, style int) error {
+	if !SetPlayerMapIcon(p.ID, iconid, x, y, z, markertype, color, style) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// RemoveMapIcon calls RemovePlayerMapIcon for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/RemovePlayerMapIcon
+func (p *Player) RemoveMapIcon(iconid int) error {
+	if !RemovePlayerMapIcon(p.ID, iconid) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// AllowTeleport calls AllowPlayerTeleport for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/AllowPlayerTeleport
+func (p *Player) AllowTeleport(allow bool) error {
+	if !AllowPlayerTeleport(p.ID, allow) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetCameraPos calls SetPlayerCameraPos for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerCameraPos
+func (p *Player) SetCameraPos(x float32, y float32, z float32) error {
+	if !SetPlayerCameraPos(p.ID, x, y, z) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetCameraLookAt calls SetPlayerCameraLookAt for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerCameraLookAt
+func (p *Player) SetCameraLookAt(x float32, y float32, z float32, cut int) error {
+	if !SetPlayerCameraLookAt(p.ID, x, y, z, cut) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SetCameraBehind calls SetCameraBehindPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetCameraBehindPlayer
+func (p *Player) SetCameraBehind() error {
+	if !SetCameraBehindPlayer(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetCameraPos calls GetPlayerCameraPos for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerCameraPos
+func (p *Player) GetCameraPos() (x float32, y float32, z float32, err error) {
+	if !GetPlayerCameraPos(p.ID, &x, &y, &z) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// GetCameraFrontVector calls GetPlayerCameraFrontVector for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerCameraFrontVector
+func (p *Player) GetCameraFrontVector() (x float32, y float32, z float32, err error) {
+	if !GetPlayerCameraFrontVector(p.ID, &x, &y, &z) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// GetCameraMode calls GetPlayerCameraMode for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerCameraMode
+func (p *Player) GetCameraMode() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerCameraMode(p.ID), nil
+}
+
+// EnableCameraTarget calls EnablePlayerCameraTarget for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/EnablePlayerCameraTarget
+func (p *Player) EnableCameraTarget(enable bool) error {
+	if !EnablePlayerCameraTarget(p.ID, enable) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetCameraTargetObject calls GetPlayerCameraTargetObject for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerCameraTargetObject
+func (p *Player) GetCameraTargetObject() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerCameraTargetObject(p.ID), nil
+}
+
+// GetCameraTargetVehicle calls GetPlayerCameraTargetVehicle for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerCameraTargetVehicle
+func (p *Player) GetCameraTargetVehicle() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerCameraTargetVehicle(p.ID), nil
+}
+
+// GetCameraTargetPlayer calls GetPlayerCameraTargetPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerCameraTargetPlayer
+func (p *Player) GetCameraTargetPlayer() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerCameraTargetPlayer(p.ID), nil
+}
+
+// GetCameraTargetActor calls GetPlayerCameraTargetActor for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerCameraTargetActor
+func (p *Player) GetCameraTargetActor() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerCameraTargetActor(p.ID), nil
+}
+
+// GetCameraAspectRatio calls GetPlayerCameraAspectRatio for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerCameraAspectRatio
+func (p *Player) GetCameraAspectRatio() (float32, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerCameraAspectRatio(p.ID), nil
+}
+
+// GetCameraZoom calls GetPlayerCameraZoom for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerCameraZoom
+func (p *Player) GetCameraZoom() (float32, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerCameraZoom(p.ID), nil
+}
+
+// AttachCameraToObject calls AttachCameraToObject for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/AttachCameraToObject
+func (p *Player) AttachCameraToObject(objectid int) error {
+	if !AttachCameraToObject(p.ID, objectid) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// InterpolateCameraPos calls InterpolateCameraPos for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/InterpolateCameraPos
+func (p *Player) InterpolateCameraPos(FromX float32, FromY float32, FromZ float32, ToX float32, ToY float32, ToZ float32, time int, cut int) error {
+	if !InterpolateCameraPos(p.ID, FromX, FromY, FromZ, ToX, ToY, ToZ, time, cut) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// InterpolateCameraLookAt calls InterpolateCameraLookAt for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/InterpolateCameraLookAt
+func (p *Player) InterpolateCameraLookAt(FromX float32, FromY float32, FromZ float32, ToX float32, ToY float32, ToZ float32, time int, cut int) error {
+	if !InterpolateCameraLookAt(p.ID, FromX, FromY, FromZ, ToX, ToY, ToZ, time, cut) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// IsConnected calls IsPlayerConnected for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/IsPlayerConnected
+func (p *Player) IsConnected() bool {
+	return IsPlayerConnected(p.ID)
+}
+
+// IsInCheckpoint calls IsPlayerInCheckpoint for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/IsPlayerInCheckpoint
+func (p *Player) IsInCheckpoint() bool {
+	return IsPlayerInCheckpoint(p.ID)
+}
+
+// IsInRaceCheckpoint calls IsPlayerInRaceCheckpoint for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/IsPlayerInRaceCheckpoint
+func (p *Player) IsInRaceCheckpoint() bool {
+	return IsPlayerInRaceCheckpoint(p.ID)
+}
+
+// SetVirtualWorld calls SetPlayerVirtualWorld for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SetPlayerVirtualWorld
+func (p *Player) SetVirtualWorld(worldid int) error {
+	if !SetPlayerVirtualWorld(p.ID, worldid) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetVirtualWorld calls GetPlayerVirtualWorld for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerVirtualWorld
+func (p *Player) GetVirtualWorld() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerVirtualWorld(p.ID), nil
+}
+
+// EnableStuntBonus calls EnableStuntBonusForPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/EnableStuntBonusForPlayer
+func (p *Player) EnableStuntBonus(enable bool) error {
+	if !EnableStuntBonusForPlayer(p.ID, enable) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// ToggleSpectating calls TogglePlayerSpectating for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/TogglePlayerSpectating
+func (p *Player) ToggleSpectating(toggle bool) error {
+	if !TogglePlayerSpectating(p.ID, toggle) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SpectatePlayer calls PlayerSpectatePlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/PlayerSpectatePlayer
+func (p *Player) SpectatePlayer(targetplayer *Player, mode int) error {
+	if !PlayerSpectatePlayer(p.ID, playerID(targetplayer), mode) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SpectateVehicle calls PlayerSpectateVehicle for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/PlayerSpectateVehicle
+func (p *Player) SpectateVehicle(targetvehicle *Vehicle, mode int) error {
+	if !PlayerSpectateVehicle(p.ID, vehicleID(targetvehicle), mode) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// StartRecordingData calls StartRecordingPlayerData for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/StartRecordingPlayerData
+func (p *Player) StartRecordingData(recordtype int, recordname string) error {
+	if !StartRecordingPlayerData(p.ID, recordtype, recordname) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// StopRecordingData calls StopRecordingPlayerData for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/StopRecordingPlayerData
+func (p *Player) StopRecordingData() error {
+	if !StopRecordingPlayerData(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// CreateExplosion calls CreateExplosionForPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/CreateExplosionForPlayer
+func (p *Player) CreateExplosion(X float32, Y float32, Z float32, type_ int, Radius float32) error {
+	if !CreateExplosionForPlayer(p.ID, X, Y, Z, type_, Radius) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SendChatTo calls SendPlayerMessageToPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SendPlayerMessageToPlayer
+func (p *Player) SendChatTo(sender *Player, message string) error {
+	if !SendPlayerMessageToPlayer(p.ID, playerID(sender), message) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SendDeathMessage calls SendDeathMessageToPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SendDeathMessageToPlayer
+func (p *Player) SendDeathMessage(killer *Player, killee *Player, weapon int) error {
+	if !SendDeathMessageToPlayer(p.ID, playerID(killer), playerID(killee), weapon) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GameText calls GameTextForPlayer for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GameTextForPlayer
+func (p *Player) GameText(text string, time int, style int) error {
+	if !GameTextForPlayer(p.ID, text, time, style) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// IsNPC calls IsPlayerNPC for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/IsPlayerNPC
+func (p *Player) IsNPC() bool {
+	return IsPlayerNPC(p.ID)
+}
+
+// IsAdmin calls IsPlayerAdmin for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/IsPlayerAdmin
+func (p *Player) IsAdmin() bool {
+	return IsPlayerAdmin(p.ID)
+}
+
+// GetNetworkStats calls GetPlayerNetworkStats for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerNetworkStats
+func (p *Player) GetNetworkStats() (retstr string, err error) {
+	if !GetPlayerNetworkStats(p.ID, &retstr, 1024) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// NetStatsMessagesReceived calls NetStats_MessagesReceived for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/NetStats_MessagesReceived
+func (p *Player) NetStatsMessagesReceived() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return NetStats_MessagesReceived(p.ID), nil
+}
+
+// NetStatsBytesReceived calls NetStats_BytesReceived for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/NetStats_BytesReceived
+func (p *Player) NetStatsBytesReceived() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return NetStats_BytesReceived(p.ID), nil
+}
+
+// NetStatsMessagesSent calls NetStats_MessagesSent for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/NetStats_MessagesSent
+func (p *Player) NetStatsMessagesSent() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return NetStats_MessagesSent(p.ID), nil
+}
+
+// NetStatsBytesSent calls NetStats_BytesSent for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/NetStats_BytesSent
+func (p *Player) NetStatsBytesSent() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return NetStats_BytesSent(p.ID), nil
+}
+
+// NetStatsMessagesRecvPerSecond calls NetStats_MessagesRecvPerSecond for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/NetStats_MessagesRecvPerSecond
+func (p *Player) NetStatsMessagesRecvPerSecond() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return NetStats_MessagesRecvPerSecond(p.ID), nil
+}
+
+// NetStatsPacketLossPercent calls NetStats_PacketLossPercent for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/NetStats_PacketLossPercent
+func (p *Player) NetStatsPacketLossPercent() (float32, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return NetStats_PacketLossPercent(p.ID), nil
+}
+
+// NetStatsConnectionStatus calls NetStats_ConnectionStatus for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/NetStats_ConnectionStatus
+func (p *Player) NetStatsConnectionStatus() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return NetStats_ConnectionStatus(p.ID), nil
+}
+
+// GetMenu calls GetPlayerMenu for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/GetPlayerMenu
+func (p *Player) GetMenu() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return GetPlayerMenu(p.ID), nil
+}
+
+// SelectTextDraw calls SelectTextDraw for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SelectTextDraw
+func (p *Player) SelectTextDraw(hovercolor int) error {
+	if !SelectTextDraw(p.ID, hovercolor) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// CancelSelectTextDraw calls CancelSelectTextDraw for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/CancelSelectTextDraw
+func (p *Player) CancelSelectTextDraw() error {
+	if !CancelSelectTextDraw(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// CreateTextLabel calls CreatePlayer3DTextLabel for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/CreatePlayer3DTextLabel
+func (p *Player) CreateTextLabel(text string, color int, x float32, y float32, z float32, DrawDistance float32, attachedplayer *Player, attachedvehicle *Vehicle, testLOS bool) (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return CreatePlayer3DTextLabel(p.ID, text, color, x, y, z, DrawDistance, playerID(attachedplayer), vehicleID(attachedvehicle), testLOS), nil
+}
+
+// DeleteTextLabel calls DeletePlayer3DTextLabel for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/DeletePlayer3DTextLabel
+func (p *Player) DeleteTextLabel(id int) error {
+	if !DeletePlayer3DTextLabel(p.ID, id) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// UpdateTextLabelText calls UpdatePlayer3DTextLabelText for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/UpdatePlayer3DTextLabelText
+func (p *Player) UpdateTextLabelText(id int, color int, text string) error {
+	if !UpdatePlayer3DTextLabelText(p.ID, id, color, text) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GPCI calls gpci for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/gpci
+func (p *Player) GPCI() (buffer string, err error) {
+	if !gpci(p.ID, &buffer, 1024) {
+		err = playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return
+}
+
+// RedirectDownload calls RedirectDownload for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/RedirectDownload
+func (p *Player) RedirectDownload(url string) error {
+	if !RedirectDownload(p.ID, url) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// EditObject calls EditObject for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/EditObject
+func (p *Player) EditObject(objectid int) error {
+	if !EditObject(p.ID, objectid) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// SelectObject calls SelectObject for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/SelectObject
+func (p *Player) SelectObject() error {
+	if !SelectObject(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// CancelEdit calls CancelEdit for the player.
+// For documentation, please visit https://open.mp/docs/scripting/functions/CancelEdit
+func (p *Player) CancelEdit() error {
+	if !CancelEdit(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// ChangePedColour calls UGMPChangePlayerPedColour for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/ChangePlayerPedColour
+func (p *Player) ChangePedColour(colour1 int, colour2 int, colour3 int, colour4 int) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPChangePlayerPedColour(p.ID, colour1, colour2, colour3, colour4)
+	return nil
+}
+
+// ToggleMoon calls UGMPTogglePlayerMoon for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerMoon
+func (p *Player) ToggleMoon(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerMoon(p.ID, enable)
+	return nil
+}
+
+// ToggleStars calls UGMPTogglePlayerStars for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerStars
+func (p *Player) ToggleStars(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerStars(p.ID, enable)
+	return nil
+}
+
+// ToggleLowClouds calls UGMPTogglePlayerLowClouds for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerLowClouds
+func (p *Player) ToggleLowClouds(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerLowClouds(p.ID, enable)
+	return nil
+}
+
+// ToggleFluffyClouds calls UGMPTogglePlayerFluffyClouds for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerFluffyClouds
+func (p *Player) ToggleFluffyClouds(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerFluffyClouds(p.ID, enable)
+	return nil
+}
+
+// ToggleRainbow calls UGMPTogglePlayerRainbow for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerRainbow
+func (p *Player) ToggleRainbow(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerRainbow(p.ID, enable)
+	return nil
+}
+
+// SetSeason calls UGMPSetPlayerSeason for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/SetPlayerSeason
+func (p *Player) SetSeason(season int) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPSetPlayerSeason(p.ID, season)
+	return nil
+}
+
+// IsSASunPositionFormulaEnabled calls UGMPIsSASunPositionFormulaEnabled for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/IsSASunPositionFormulaEnabled
+func (p *Player) IsSASunPositionFormulaEnabled() (int, error) {
+	if !IsPlayerConnected(p.ID) {
+		return 0, playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return UGMPIsSASunPositionFormulaEnabled(p.ID), nil
+}
+
+// ToggleNightVision calls UGMPTogglePlayerNightVision for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerNightVision
+func (p *Player) ToggleNightVision(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerNightVision(p.ID, enable)
+	return nil
+}
+
+// ToggleInfraRed calls UGMPTogglePlayerInfraRed for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerInfraRed
+func (p *Player) ToggleInfraRed(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerInfraRed(p.ID, enable)
+	return nil
+}
+
+// ToggleCCTV calls UGMPTogglePlayerCCTV for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerCCTV
+func (p *Player) ToggleCCTV(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerCCTV(p.ID, enable)
+	return nil
+}
+
+// ToggleFogOverlay calls UGMPTogglePlayerFogOverlay for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerFogOverlay
+func (p *Player) ToggleFogOverlay(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerFogOverlay(p.ID, enable)
+	return nil
+}
+
+// ToggleDarknessFilter calls UGMPTogglePlayerDarknessFilter for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerDarknessFilter
+func (p *Player) ToggleDarknessFilter(enable bool, darknessAlpha int) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerDarknessFilter(p.ID, enable, darknessAlpha)
+	return nil
+}
+
+// ToggleVideoCameraOverlay calls UGMPTogglePlayerVideoCameraOverlay for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerVideoCameraOverlay
+func (p *Player) ToggleVideoCameraOverlay(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerVideoCameraOverlay(p.ID, enable)
+	return nil
+}
+
+// SetKnockedOffBikeState calls UGMPSetPlayerKnockedOffBikeState for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/SetPlayerKnockedOffBikeState
+func (p *Player) SetKnockedOffBikeState(knockState int) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPSetPlayerKnockedOffBikeState(p.ID, knockState)
+	return nil
+}
+
+// SetFireProof calls UGMPSetPlayerFireProof for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/SetPlayerFireProof
+func (p *Player) SetFireProof(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPSetPlayerFireProof(p.ID, enable)
+	return nil
+}
+
+// ToggleInfiniteSprint calls UGMPTogglePlayerInfiniteSprint for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerInfiniteSprint
+func (p *Player) ToggleInfiniteSprint(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerInfiniteSprint(p.ID, enable)
+	return nil
+}
+
+// ToggleSun calls UGMPTogglePlayerSun for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerSun
+func (p *Player) ToggleSun(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerSun(p.ID, enable)
+	return nil
+}
+
+// ToggleRubbish calls UGMPTogglePlayerRubbish for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerRubbish
+func (p *Player) ToggleRubbish(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerRubbish(p.ID, enable)
+	return nil
+}
+
+// IsRubbishVisible calls UGMPIsRubbishVisibleForPlayer for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/IsRubbishVisibleForPlayer
+func (p *Player) IsRubbishVisible() bool {
+	return UGMPIsRubbishVisibleForPlayer(p.ID)
+}
+
+// ToggleGrass calls UGMPTogglePlayerGrass for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/TogglePlayerGrass
+func (p *Player) ToggleGrass(enable bool) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	UGMPTogglePlayerGrass(p.ID, enable)
+	return nil
+}
+
+// SetAircraftHeightLimit calls UGMPSetAircraftHeightLimitForPlayer for the player.
+// For documentation, please visit https://gtaundergroundmod.com/pages/ug-mp/documentation/native/SetAircraftHeightLimitForPlayer
+func (p *Player) SetAircraftHeightLimit(limit float32) error {
+	if !UGMPSetAircraftHeightLimitForPlayer(p.ID, limit) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package sampgo
+
+/*
+#cgo windows CFLAGS: -I./lib -I./lib/amx -Wno-attributes -Wno-implicit-function-declaration
+#cgo windows CFLAGS: -DHAVE_INTTYPES_H -DHAVE_MALLOC_H -DHAVE_STDINT_H -DWIN32
+#cgo windows LDFLAGS: -Wl,--subsystem,windows,--kill-at
+
+#cgo linux CFLAGS: -I./lib -I./lib/amx -Wno-attributes -Wno-implicit-function-declaration
+#cgo linux CFLAGS: -DHAVE_INTTYPES_H -DHAVE_MALLOC_H -DHAVE_STDINT_H -DLINUX -D_GNU_SOURCE
+#cgo linux LDFLAGS: -ldl
+
+#ifndef GOLANG_APP
+#define GOLANG_APP
+
+#include "main.h"
+
+#endif
+*/
+import "C"
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+)
+
+// PublicCall is a public function about to be run by the server, such as a
+// callback raised by another plugin.
+type PublicCall struct {
+	// Script is the script the public is run in.
+	Script *AMX
+	// Name is the name of the public.
+	Name string
+	// Args holds the arguments decoded according to the format passed to
+	// OnPublic. By-reference arguments are pointers and are written back to the
+	// script once the handler returns.
+	Args []interface{}
+	// Params holds the raw arguments. Strings, arrays and references are
+	// addresses that can be read with the Script's String and Ref methods.
+	Params []Cell
+
+	result *publicResult
+}
+
+// publicResult is shared by every handler of a single public call.
+type publicResult struct {
+	retval *C.cell
+	stop   bool
+}
+
+// SetReturn overrides the value returned by the public. The public itself is
+// then no longer run in the script, nor passed on to plugins loaded after this
+// one, which includes the built-in events of this package.
+func (call *PublicCall) SetReturn(value Cell) {
+	if call.result.retval != nil {
+		*call.result.retval = C.cell(value)
+	}
+	call.result.stop = true
+}
+
+// Stopped reports whether a handler called SetReturn.
+func (call *PublicCall) Stopped() bool {
+	return call.result.stop
+}
+
+// OnPublic registers a handler called whenever the public name is run, such
+// as OnPlayerEnterDynamicArea raised by the Streamer plugin. format describes
+// the public's parameters with the specifiers of sampgo_CallEvent, except that
+// plain values are passed directly rather than by reference, for example "ii"
+// for OnPlayerEnterDynamicArea(playerid, areaid).
+//
+// Only publics run on the game mode are seen, this includes functions called
+// from filter scripts with CallRemoteFunction.
+func OnPublic(name, format string, handler func(call *PublicCall)) (*Subscription, error) {
+	return OnPublicWithPriority(name, format, PriorityNormal, handler)
+}
+
+// OnPublicWithPriority registers a handler like OnPublic that is called before
+// all handlers of a lower priority.
+func OnPublicWithPriority(name, format string, priority Priority, handler func(call *PublicCall)) (*Subscription, error) {
+	if name == "" {
+		return nil, fmt.Errorf("public name is empty")
+	}
+	if handler == nil {
+		return nil, fmt.Errorf("handler for public %s is nil", name)
+	}
+
+	specs, err := parseFormat(format)
+	if err != nil {
+		return nil, fmt.Errorf("public %s: %w", name, err)
+	}
+
+	eventName := publicPrefix + name
+	evt := &event{Type: Repeat, Priority: priority}
+	// A malformed argument is reported through the error policy and the
+	// handler is skipped for this call.
+	evt.Handler = func(call *PublicCall) {
+		if err := decodePublic(call, specs, handler); err != nil {
+			handlerFailed(eventName, evt, fmt.Errorf("public %s with format %q: %w", name, format, err), nil)
+		}
+	}
+
+	if len(events[eventName]) == 0 {
+		cname := C.CString(name)
+		C.hookPublic(cname)
+		C.free(unsafe.Pointer(cname))
+	}
+	return insert(eventName, evt), nil
+}
+
+// decodePublic decodes the arguments of call according to specs, runs the
+// handler and writes the by-reference arguments back to the script.
+func decodePublic(call *PublicCall, specs []argSpec, handler func(call *PublicCall)) error {
+	if len(call.Params) < len(specs) {
+		return fmt.Errorf("needs %d arguments, got %d", len(specs), len(call.Params))
+	}
+
+	args := make([]interface{}, len(specs))
+	for i, spec := range specs {
+		value, err := spec.decode(call.Script.amx, C.cell(call.Params[i]))
+		if err != nil {
+			return fmt.Errorf("argument %d: %w", i, err)
+		}
+		args[i] = value
+	}
+	call.Args = args
+
+	handler(call)
+
+	for i, spec := range specs {
+		if err := spec.write(call.Script.amx, C.cell(call.Params[i]), args[i]); err != nil {
+			return fmt.Errorf("argument %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// unhookPublic stops the public of an event removed from the events map from
+// reaching onPublicCall, if it is one.
+func unhookPublic(eventName string) {
+	if !strings.HasPrefix(eventName, publicPrefix) {
+		return
+	}
+
+	cname := C.CString(eventName[len(publicPrefix):])
+	defer C.free(unsafe.Pointer(cname))
+	C.unhookPublic(cname)
+}
+
+// decode converts a public argument into its Go value. Unlike the variadic
+// arguments of sampgo_CallEvent, only strings, arrays and references are
+// passed as addresses.
+func (spec argSpec) decode(amx *C.AMX, value C.cell) (interface{}, error) {
+	if spec.ref || spec.kind == 's' || spec.kind == 'a' {
+		return spec.read(amx, value)
+	}
+
+	switch spec.kind {
+	case 'b':
+		return value != 0, nil
+	case 'c':
+		return rune(value), nil
+	case 'f':
+		return cellToFloat(value), nil
+	default:
+		return int(value), nil
+	}
+}
+
+//export onPublicCall
+func onPublicCall(amx *C.AMX, name *C.char_t, params *C.cell, retval *C.cell) bool {
+	eventName := publicPrefix + C.GoString(C.constToNonConst(name))
+	if len(events[eventName]) == 0 {
+		return false
+	}
+
+	all := nativeParams(params)
+	call := PublicCall{
+		Script: scriptFor(amx),
+		Name:   eventName[len(publicPrefix):],
+		Params: (*[maxCells]Cell)(unsafe.Pointer(&all[0]))[1:len(all):len(all)],
+		result: &publicResult{retval: retval},
+	}
+
+	dispatch(eventName, false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(*PublicCall))
+		if !ok {
+			return false, false
+		}
+		// Each handler decodes the arguments with its own format.
+		c := call
+		fn(&c)
+		return true, true
+	})
+
+	return call.result.stop
+}
@@ -17,71 +17,33 @@ package sampgo
 #endif
 */
 import "C"
-import (
-	"fmt"
-	"unsafe"
-)
+import "unsafe"
 
-type EventType int
-
-const (
-	Repeat EventType = iota
-	OnceOnly
-)
-
-type event struct {
-	Handler interface{}
-	Type    EventType
-}
-
-var events = make(map[string]event)
 var mainEvent func() = nil
 
 //export onTick
 func onTick() {
-	evt, ok := events["tick"]
-	if !ok {
-		return
-	}
-
-	fn, ok := evt.Handler.(func())
-	if !ok {
-		return
-	}
-	fn()
-
-	if evt.Type == OnceOnly {
-		// If this event was registered only once, then reassign this event to a new blank struct.
-		// PoC code. Still needs to be implemented completely.
-	}
-
-	return
+	mainThread.drain()
+
+	dispatch("tick", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func())
+		if !ok {
+			return false, false
+		}
+		fn()
+		return true, true
+	})
 }
 
-// On registers an event with a handler.
-func On(eventName string, handler interface{}) error {
-	_, ok := events[eventName]
-	if ok {
-		return fmt.Errorf("this handler already exists")
-	}
-
-	events[eventName] = event{Handler: handler, Type: Repeat}
-	_ = Print(fmt.Sprintf("Registered %s event", eventName))
-
-	return nil
+// OnTick registers a handler that is called on every server tick.
+func OnTick(handler func()) (*Subscription, error) {
+	return On("tick", handler)
 }
 
-// Once registers an event with a handler one time only.
-func Once(eventName string, handler interface{}) error {
-	_, ok := events[eventName]
-	if ok {
-		return fmt.Errorf("this handler already exists")
-	}
-
-	events[eventName] = event{Handler: handler, Type: OnceOnly}
-	_ = Print(fmt.Sprintf("Registered %s event", eventName))
-
-	return nil
+// onMainThread reports whether the caller runs on the server thread, such as
+// an event handler or a function queued with RunOnMainThread.
+func onMainThread() bool {
+	return bool(C.isMainThread())
 }
 
 // Print allows you to print to the SAMP console.
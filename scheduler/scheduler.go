@@ -0,0 +1,215 @@
+// Package scheduler runs delayed and repeating functions on the server thread.
+//
+// Timers are checked on every server tick, so their callbacks may safely call
+// natives. A timer fires on the first tick after it is due, which means its
+// precision is bound to the server's sleep setting. All functions of this
+// package must be called from the server thread, e.g. from an event handler.
+package scheduler
+
+import (
+	"container/heap"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/sampgo/sampgo"
+)
+
+// Timer is a handle to a scheduled function.
+type Timer struct {
+	fn       func()
+	due      time.Time
+	interval time.Duration
+	playerID int
+	index    int
+	active   bool
+	failures int
+}
+
+type timerQueue []*Timer
+
+func (q timerQueue) Len() int           { return len(q) }
+func (q timerQueue) Less(i, j int) bool { return q[i].due.Before(q[j].due) }
+
+func (q timerQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *timerQueue) Push(x interface{}) {
+	t := x.(*Timer)
+	t.index = len(*q)
+	*q = append(*q, t)
+}
+
+func (q *timerQueue) Pop() interface{} {
+	old := *q
+	t := old[len(old)-1]
+	old[len(old)-1] = nil
+	*q = old[:len(old)-1]
+	t.index = -1
+	return t
+}
+
+var (
+	queue        timerQueue
+	playerTimers = make(map[int]map[*Timer]struct{})
+
+	tickHooked       bool
+	disconnectHooked bool
+)
+
+// After calls fn once after d has passed.
+func After(d time.Duration, fn func()) *Timer {
+	return schedule(d, 0, sampgo.InvalidPlayerId, fn)
+}
+
+// Every calls fn every d until the timer is cancelled. Like time.NewTicker, it
+// panics if d is not positive.
+func Every(d time.Duration, fn func()) *Timer {
+	checkInterval("Every", d)
+	return schedule(d, d, sampgo.InvalidPlayerId, fn)
+}
+
+// AfterPlayer calls fn once after d has passed, unless the player
+// disconnects first.
+func AfterPlayer(p sampgo.Player, d time.Duration, fn func()) *Timer {
+	return schedule(d, 0, p.ID, fn)
+}
+
+// EveryPlayer calls fn every d until the timer is cancelled or the player
+// disconnects. Like time.NewTicker, it panics if d is not positive.
+func EveryPlayer(p sampgo.Player, d time.Duration, fn func()) *Timer {
+	checkInterval("EveryPlayer", d)
+	return schedule(d, d, p.ID, fn)
+}
+
+// checkInterval panics for a repeating timer that would never repeat.
+func checkInterval(name string, d time.Duration) {
+	if d <= 0 {
+		panic(fmt.Sprintf("scheduler: non-positive interval %v for %s", d, name))
+	}
+}
+
+// Cancel stops the timer. Cancelling a timer that has already fired or
+// was cancelled before does nothing.
+func (t *Timer) Cancel() {
+	if !t.active {
+		return
+	}
+	t.active = false
+
+	if t.index >= 0 {
+		heap.Remove(&queue, t.index)
+	}
+	t.forgetPlayer()
+}
+
+// Active reports whether the timer is still going to fire.
+func (t *Timer) Active() bool {
+	return t.active
+}
+
+// CancelAll stops every pending timer.
+func CancelAll() {
+	for _, t := range queue {
+		t.active = false
+		t.index = -1
+	}
+	queue = nil
+	playerTimers = make(map[int]map[*Timer]struct{})
+}
+
+func schedule(d, interval time.Duration, playerID int, fn func()) *Timer {
+	hook(playerID != sampgo.InvalidPlayerId)
+
+	t := &Timer{
+		fn:       fn,
+		due:      time.Now().Add(d),
+		interval: interval,
+		playerID: playerID,
+		active:   true,
+	}
+	heap.Push(&queue, t)
+
+	if playerID != sampgo.InvalidPlayerId {
+		timers, ok := playerTimers[playerID]
+		if !ok {
+			timers = make(map[*Timer]struct{})
+			playerTimers[playerID] = timers
+		}
+		timers[t] = struct{}{}
+	}
+
+	return t
+}
+
+// hook registers the tick, and if needed the disconnect, handlers the first
+// time a timer is scheduled.
+func hook(perPlayer bool) {
+	if !tickHooked {
+		tickHooked = true
+		_, _ = sampgo.OnTick(tick)
+	}
+
+	if perPlayer && !disconnectHooked {
+		disconnectHooked = true
+		_, _ = sampgo.OnWithPriority("playerDisconnect", sampgo.PriorityLowest, func(p sampgo.Player, reason int) bool {
+			for t := range playerTimers[p.ID] {
+				t.Cancel()
+			}
+			delete(playerTimers, p.ID)
+			return true
+		})
+	}
+}
+
+func (t *Timer) forgetPlayer() {
+	if t.playerID == sampgo.InvalidPlayerId {
+		return
+	}
+
+	timers := playerTimers[t.playerID]
+	delete(timers, t)
+	if len(timers) == 0 {
+		delete(playerTimers, t.playerID)
+	}
+}
+
+func tick() {
+	now := time.Now()
+
+	for len(queue) > 0 && !queue[0].due.After(now) {
+		t := queue[0]
+
+		if t.interval > 0 {
+			t.due = t.due.Add(t.interval)
+			if !t.due.After(now) {
+				// Skip the runs missed during a long tick instead of bursting them.
+				t.due = now.Add(t.interval)
+			}
+			heap.Fix(&queue, 0)
+		} else {
+			heap.Pop(&queue)
+			t.active = false
+			t.forgetPlayer()
+		}
+
+		run(t)
+	}
+}
+
+// run calls a timer's function, recovering from a panic so a single timer
+// can not stop the others from firing. The panic is reported through the error
+// policy set with sampgo.SetErrorPolicy, which may cancel the timer.
+func run(t *Timer) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.failures++
+			sampgo.ReportFailure("Timer", t.failures, t.Cancel, fmt.Errorf("panic: %v", r), debug.Stack())
+		}
+	}()
+
+	t.fn()
+}
@@ -0,0 +1,460 @@
+package sampgo
+
+/*
+#cgo windows CFLAGS: -I./lib -I./lib/amx -Wno-attributes -Wno-implicit-function-declaration
+#cgo windows CFLAGS: -DHAVE_INTTYPES_H -DHAVE_MALLOC_H -DHAVE_STDINT_H -DWIN32
+#cgo windows LDFLAGS: -Wl,--subsystem,windows,--kill-at
+
+#cgo linux CFLAGS: -I./lib -I./lib/amx -Wno-attributes -Wno-implicit-function-declaration
+#cgo linux CFLAGS: -DHAVE_INTTYPES_H -DHAVE_MALLOC_H -DHAVE_STDINT_H -DLINUX -D_GNU_SOURCE
+#cgo linux LDFLAGS: -ldl
+
+#ifndef GOLANG_APP
+#define GOLANG_APP
+
+#include "main.h"
+
+#endif
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"unsafe"
+)
+
+// ErrPublicNotFound is returned when no loaded script has the called public.
+var ErrPublicNotFound = errors.New("public function not found")
+
+// ErrScriptUnloaded is returned when calling into or accessing the memory of a
+// script that has been unloaded.
+var ErrScriptUnloaded = errors.New("script is unloaded")
+
+// ScriptKind tells the game mode apart from filter scripts.
+type ScriptKind int
+
+const (
+	GameMode ScriptKind = iota
+	FilterScript
+)
+
+func (k ScriptKind) String() string {
+	if k == GameMode {
+		return "game mode"
+	}
+	return "filter script"
+}
+
+// AMX is a loaded Pawn script, either the game mode or a filter script.
+type AMX struct {
+	amx    *C.AMX
+	kind   ScriptKind
+	name   string
+	loaded bool
+}
+
+var scripts []*AMX
+
+// Names of the scripts about to be loaded. The server loads the scripts listed
+// in server.cfg in order at startup, LoadFilterScript and ChangeGameMode set
+// the name of the script they load themselves.
+var (
+	configRead        bool
+	pendingFilters    []string
+	pendingGameMode   string
+	loadingFilterName *string
+)
+
+//export onAmxLoad
+func onAmxLoad(amx *C.AMX) {
+	if !configRead {
+		configRead = true
+		readServerConfig("server.cfg")
+	}
+
+	script := &AMX{amx: amx, kind: FilterScript, loaded: true}
+	if C.amxHasMain(amx) {
+		script.kind = GameMode
+		script.name = pendingGameMode
+	} else if loadingFilterName != nil {
+		script.name = *loadingFilterName
+	} else if len(pendingFilters) > 0 {
+		script.name = pendingFilters[0]
+		pendingFilters = pendingFilters[1:]
+	}
+
+	scripts = append(scripts, script)
+	script.registerNatives()
+
+	dispatch("scriptLoad", false, func(handler interface{}) (bool, bool) {
+		fn, ok := handler.(func(*AMX))
+		if !ok {
+			return false, false
+		}
+		fn(script)
+		return true, true
+	})
+}
+
+//export onAmxUnload
+func onAmxUnload(amx *C.AMX) {
+	for i, script := range scripts {
+		if script.amx != amx {
+			continue
+		}
+
+		dispatch("scriptUnload", false, func(handler interface{}) (bool, bool) {
+			fn, ok := handler.(func(*AMX))
+			if !ok {
+				return false, false
+			}
+			fn(script)
+			return true, true
+		})
+
+		script.loaded = false
+		scripts = append(scripts[:i:i], scripts[i+1:]...)
+		return
+	}
+}
+
+// readServerConfig reads the names of the scripts loaded at startup.
+func readServerConfig(path string) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		switch fields[0] {
+		case "filterscripts":
+			pendingFilters = fields[1:]
+		case "gamemode0":
+			pendingGameMode = fields[1]
+		}
+	}
+}
+
+// OnScriptLoad registers a handler that is called when the game mode or a
+// filter script has been loaded.
+func OnScriptLoad(handler func(script *AMX)) (*Subscription, error) {
+	return On("scriptLoad", handler)
+}
+
+// OnScriptUnload registers a handler that is called when the game mode or a
+// filter script is about to be unloaded, to clean up any state kept for it.
+func OnScriptUnload(handler func(script *AMX)) (*Subscription, error) {
+	return On("scriptUnload", handler)
+}
+
+// scriptFor returns the loaded script wrapping amx.
+func scriptFor(amx *C.AMX) *AMX {
+	for _, script := range scripts {
+		if script.amx == amx {
+			return script
+		}
+	}
+	return &AMX{amx: amx, kind: FilterScript, loaded: true}
+}
+
+// Scripts returns every loaded script in the order they were loaded.
+func Scripts() []*AMX {
+	return append([]*AMX(nil), scripts...)
+}
+
+// GameModeScript returns the loaded game mode, or nil if there is none.
+func GameModeScript() *AMX {
+	for _, script := range scripts {
+		if script.kind == GameMode {
+			return script
+		}
+	}
+	return nil
+}
+
+// FilterScripts returns every loaded filter script in the order they were loaded.
+func FilterScripts() []*AMX {
+	var filters []*AMX
+	for _, script := range scripts {
+		if script.kind == FilterScript {
+			filters = append(filters, script)
+		}
+	}
+	return filters
+}
+
+// FindFilterScript returns the loaded filter script called name.
+func FindFilterScript(name string) (*AMX, bool) {
+	for _, script := range scripts {
+		if script.kind == FilterScript && script.name == name {
+			return script, true
+		}
+	}
+	return nil, false
+}
+
+// LoadFilterScript loads the filter script called name, like the loadfs RCON
+// command, and returns it.
+func LoadFilterScript(name string) (*AMX, error) {
+	if _, ok := FindFilterScript(name); ok {
+		return nil, fmt.Errorf("filter script %s is already loaded", name)
+	}
+
+	loadingFilterName = &name
+	SendRconCommand("loadfs " + name)
+	loadingFilterName = nil
+
+	script, ok := FindFilterScript(name)
+	if !ok {
+		return nil, fmt.Errorf("filter script %s could not be loaded", name)
+	}
+	return script, nil
+}
+
+// UnloadFilterScript unloads the filter script called name, like the
+// unloadfs RCON command.
+func UnloadFilterScript(name string) error {
+	if _, ok := FindFilterScript(name); !ok {
+		return fmt.Errorf("filter script %s is not loaded", name)
+	}
+
+	SendRconCommand("unloadfs " + name)
+	return nil
+}
+
+// ReloadFilterScript reloads the filter script called name, like the
+// reloadfs RCON command, and returns the new instance.
+func ReloadFilterScript(name string) (*AMX, error) {
+	if err := UnloadFilterScript(name); err != nil {
+		return nil, err
+	}
+	return LoadFilterScript(name)
+}
+
+// ChangeGameMode ends the current game mode and loads the game mode called
+// name, like the changemode RCON command.
+func ChangeGameMode(name string) {
+	pendingGameMode = name
+	SendRconCommand("changemode " + name)
+}
+
+// Name returns the name of the script as given in server.cfg or to
+// LoadFilterScript, or an empty string for scripts loaded by other means,
+// such as the loadfs RCON command.
+func (a *AMX) Name() string {
+	return a.name
+}
+
+// Kind reports whether the script is the game mode or a filter script.
+func (a *AMX) Kind() ScriptKind {
+	return a.kind
+}
+
+// IsGameMode reports whether the script is the game mode.
+func (a *AMX) IsGameMode() bool {
+	return a.kind == GameMode
+}
+
+// Loaded reports whether the script is still loaded.
+func (a *AMX) Loaded() bool {
+	return a.loaded
+}
+
+// CallPublic calls the public function name in every loaded script that has
+// it, like CallRemoteFunction, and returns the value returned by the last one.
+// See (*AMX).CallPublic for the supported argument types.
+func CallPublic(name string, args ...interface{}) (int, error) {
+	ret, found := 0, false
+
+	for _, script := range Scripts() {
+		r, err := script.CallPublic(name, args...)
+		if errors.Is(err, ErrPublicNotFound) {
+			continue
+		}
+		if err != nil {
+			return ret, err
+		}
+		ret, found = r, true
+	}
+
+	if !found {
+		return 0, fmt.Errorf("%s: %w", name, ErrPublicNotFound)
+	}
+	return ret, nil
+}
+
+// CallPublic calls the public function name in the script, like
+// CallLocalFunction. Arguments may be int, int32, bool, float32, float64,
+// string, Player, []int, []int32 or []float32; strings and slices are copied
+// onto the script's heap for the duration of the call.
+func (a *AMX) CallPublic(name string, args ...interface{}) (int, error) {
+	if err := a.checkLoaded(); err != nil {
+		return 0, fmt.Errorf("%s: %w", name, err)
+	}
+
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	var index C.int
+	if C.amx_FindPublic(a.amx, cname, &index) != C.AMX_ERR_NONE {
+		return 0, fmt.Errorf("%s: %w", name, ErrPublicNotFound)
+	}
+
+	// Everything allocated on the heap is released at once after the call.
+	var heap C.cell
+	allocated := false
+	defer func() {
+		if allocated {
+			C.amx_Release(a.amx, heap)
+		}
+	}()
+
+	// A failed push leaves the arguments pushed before it on the stack, which
+	// would be passed to the next public executed in the script.
+	stk, paramcount := a.amx.stk, a.amx.paramcount
+
+	// Pawn expects the arguments to be pushed in reverse order.
+	for i := len(args) - 1; i >= 0; i-- {
+		addr, onHeap, err := a.push(args[i])
+		if err != nil {
+			a.amx.stk, a.amx.paramcount = stk, paramcount
+			return 0, fmt.Errorf("%s: argument %d: %w", name, i, err)
+		}
+		if onHeap && !allocated {
+			heap, allocated = addr, true
+		}
+	}
+
+	var retval C.cell
+	if err := C.amx_Exec(a.amx, &retval, index); err != C.AMX_ERR_NONE {
+		return 0, fmt.Errorf("%s: AMX error %d", name, int(err))
+	}
+
+	return int(retval), nil
+}
+
+// checkLoaded returns ErrScriptUnloaded once the script has been unloaded and
+// its memory freed.
+func (a *AMX) checkLoaded() error {
+	if !a.loaded {
+		return fmt.Errorf("%s %s: %w", a.kind, a.name, ErrScriptUnloaded)
+	}
+	return nil
+}
+
+// Ref returns a pointer to the cell at addr, such as a by-reference
+// native parameter.
+func (a *AMX) Ref(addr Cell) (*Cell, error) {
+	if err := a.checkLoaded(); err != nil {
+		return nil, err
+	}
+
+	phys, err := amxAddr(a.amx, C.cell(addr))
+	if err != nil {
+		return nil, err
+	}
+	return (*Cell)(unsafe.Pointer(phys)), nil
+}
+
+// String reads the string at addr, such as a string native parameter.
+func (a *AMX) String(addr Cell) (string, error) {
+	if err := a.checkLoaded(); err != nil {
+		return "", err
+	}
+
+	phys, err := amxAddr(a.amx, C.cell(addr))
+	if err != nil {
+		return "", err
+	}
+	return amxString(phys), nil
+}
+
+// SetString writes value as an unpacked string to the array of size cells at
+// addr, truncating it if needed.
+func (a *AMX) SetString(addr Cell, value string, size int) error {
+	if err := a.checkLoaded(); err != nil {
+		return err
+	}
+
+	dest, err := a.cellRange(addr, size)
+	if err != nil {
+		return err
+	}
+
+	cstr := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr))
+	C.amx_SetString(&dest[0], cstr, 0, 0, C.size_t(size))
+
+	return nil
+}
+
+// push pushes a single argument, returning its heap address if it was
+// allocated on the heap.
+func (a *AMX) push(arg interface{}) (C.cell, bool, error) {
+	var addr C.cell
+
+	switch v := arg.(type) {
+	case int:
+		C.amx_Push(a.amx, C.cell(v))
+	case int32:
+		C.amx_Push(a.amx, C.cell(v))
+	case bool:
+		C.amx_Push(a.amx, boolToCell(v))
+	case float32:
+		C.amx_Push(a.amx, floatToCell(v))
+	case float64:
+		C.amx_Push(a.amx, floatToCell(float32(v)))
+	case Player:
+		C.amx_Push(a.amx, C.cell(v.ID))
+	case string:
+		cstr := C.CString(v)
+		defer C.free(unsafe.Pointer(cstr))
+		if err := C.amx_PushString(a.amx, &addr, nil, cstr, 0, 0); err != C.AMX_ERR_NONE {
+			return 0, false, fmt.Errorf("AMX error %d", int(err))
+		}
+		return addr, true, nil
+	case []int, []int32, []float32:
+		array := toCells(v)
+		var ptr *C.cell
+		if len(array) > 0 {
+			ptr = &array[0]
+		}
+		if err := C.amx_PushArray(a.amx, &addr, nil, ptr, C.int(len(array))); err != C.AMX_ERR_NONE {
+			return 0, false, fmt.Errorf("AMX error %d", int(err))
+		}
+		return addr, true, nil
+	default:
+		return 0, false, fmt.Errorf("unsupported type %T", arg)
+	}
+
+	return 0, false, nil
+}
+
+// toCells converts a Go slice into cells.
+func toCells(slice interface{}) []C.cell {
+	var array []C.cell
+
+	switch v := slice.(type) {
+	case []int:
+		for _, n := range v {
+			array = append(array, C.cell(n))
+		}
+	case []int32:
+		for _, n := range v {
+			array = append(array, C.cell(n))
+		}
+	case []float32:
+		for _, f := range v {
+			array = append(array, floatToCell(f))
+		}
+	}
+
+	return array
+}
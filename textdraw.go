@@ -1,6 +1,9 @@
 package sampgo
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 const (
 	FontSanAndreas = iota
@@ -10,95 +13,582 @@ const (
 	FontSprite
 )
 
+// MaxTextDrawString is the maximum length of a textdraw's text.
+const MaxTextDrawString = 1024
+
+// TextDrawProperties holds the properties of a PlayerTextDraw. Properties that
+// have never been set are zero and use the client's default.
+type TextDrawProperties struct {
+	X, Y float32
+	Text string
+
+	Font         int
+	LetterWidth  float32
+	LetterHeight float32
+	// TextSizeX and TextSizeY are passed to PlayerTextDrawTextSize as given.
+	TextSizeX    float32
+	TextSizeY    float32
+	Alignment    int
+	Proportional bool
+	Shadow       int
+	Outline      int
+
+	Color           int
+	UseBox          bool
+	BoxColor        int
+	BackgroundColor int
+	Selectable      bool
+
+	PreviewModel     int
+	PreviewRotX      float32
+	PreviewRotY      float32
+	PreviewRotZ      float32
+	PreviewZoom      float32
+	PreviewVehColor1 int
+	PreviewVehColor2 int
+}
+
+// textDrawProperty flags the properties that have been set, which are applied
+// again when the textdraw is recreated.
+type textDrawProperty uint
+
+const (
+	propFont textDrawProperty = 1 << iota
+	propLetterSize
+	propTextSize
+	propAlignment
+	propProportional
+	propShadow
+	propOutline
+	propColor
+	propUseBox
+	propBoxColor
+	propBackgroundColor
+	propSelectable
+	propPreviewModel
+	propPreviewRot
+	propPreviewVehCol
+)
+
+// PlayerTextDraw implements OO player textdraws. It remembers its properties
+// and whether it is shown, so it can be shown again with Refresh or created
+// again with Recreate after changes.
 type PlayerTextDraw struct {
 	player   *Player
 	textDraw int
-	align    int
+	props    TextDrawProperties
+	set      textDrawProperty
+	shown    bool
 }
 
 func (p *Player) NewPlayerTextDraw(x, y float32, text string) (PlayerTextDraw, error) {
-	td := PlayerTextDraw{player: p, textDraw: CreatePlayerTextDraw(p.ID, x, y, text)}
+	td := PlayerTextDraw{player: p, textDraw: InvalidTextDraw, props: TextDrawProperties{X: x, Y: y, Text: text}}
+	if err := validateTextDrawString(InvalidTextDraw, text); err != nil {
+		return td, err
+	}
+
+	td.textDraw = CreatePlayerTextDraw(p.ID, x, y, text)
 	if td.textDraw == InvalidTextDraw {
-		return td, fmt.Errorf("invalid playertextdraw")
+		if !IsPlayerConnected(p.ID) {
+			return td, playerError(p.ID, ErrPlayerNotConnected)
+		}
+		return td, playerError(p.ID, fmt.Errorf("can not create textdraw: %w", ErrLimitReached))
 	}
 	return td, nil
 }
 
-func (p *PlayerTextDraw) Destroy() {
-	PlayerTextDrawDestroy(p.player.ID, p.textDraw)
+func (p *PlayerTextDraw) GetID() int {
+	return p.textDraw
+}
+
+func (p *PlayerTextDraw) Destroy() error {
+	if !PlayerTextDrawDestroy(p.player.ID, p.textDraw) {
+		return p.err()
+	}
+	p.shown = false
+	return nil
+}
+
+// Properties returns the properties set so far.
+func (p *PlayerTextDraw) Properties() TextDrawProperties {
+	return p.props
+}
+
+// IsShown reports whether the textdraw has been shown and not hidden since.
+func (p *PlayerTextDraw) IsShown() bool {
+	return p.shown
 }
 
-func (p *PlayerTextDraw) SetString(text string) {
-	PlayerTextDrawSetString(p.player.ID, p.textDraw, text)
+func (p *PlayerTextDraw) Show() error {
+	if !PlayerTextDrawShow(p.player.ID, p.textDraw) {
+		return p.err()
+	}
+	p.shown = true
+	return nil
+}
+
+func (p *PlayerTextDraw) Hide() error {
+	if !PlayerTextDrawHide(p.player.ID, p.textDraw) {
+		return p.err()
+	}
+	p.shown = false
+	return nil
+}
+
+// Refresh shows the textdraw again if it is shown, which is needed for most
+// changes to a visible textdraw to take effect. SetString and the preview
+// setters update it immediately.
+func (p *PlayerTextDraw) Refresh() error {
+	if !p.shown {
+		return nil
+	}
+	return p.Show()
+}
+
+// Recreate destroys the textdraw and creates it again with all properties set
+// so far, showing it again if it was shown. The ID may change.
+func (p *PlayerTextDraw) Recreate() error {
+	PlayerTextDrawDestroy(p.player.ID, p.textDraw)
+
+	p.textDraw = CreatePlayerTextDraw(p.player.ID, p.props.X, p.props.Y, p.props.Text)
+	if p.textDraw == InvalidTextDraw {
+		p.shown = false
+		if !IsPlayerConnected(p.player.ID) {
+			return playerError(p.player.ID, ErrPlayerNotConnected)
+		}
+		return playerError(p.player.ID, fmt.Errorf("can not create textdraw: %w", ErrLimitReached))
+	}
+
+	id, props := p.player.ID, p.props
+	if p.set&propFont != 0 {
+		PlayerTextDrawFont(id, p.textDraw, props.Font)
+	}
+	if p.set&propLetterSize != 0 {
+		PlayerTextDrawLetterSize(id, p.textDraw, props.LetterWidth, props.LetterHeight)
+	}
+	if p.set&propTextSize != 0 {
+		PlayerTextDrawTextSize(id, p.textDraw, props.TextSizeX, props.TextSizeY)
+	}
+	if p.set&propAlignment != 0 {
+		PlayerTextDrawAlignment(id, p.textDraw, props.Alignment)
+	}
+	if p.set&propProportional != 0 {
+		PlayerTextDrawSetProportional(id, p.textDraw, props.Proportional)
+	}
+	if p.set&propShadow != 0 {
+		PlayerTextDrawSetShadow(id, p.textDraw, props.Shadow)
+	}
+	if p.set&propOutline != 0 {
+		PlayerTextDrawSetOutline(id, p.textDraw, props.Outline)
+	}
+	if p.set&propColor != 0 {
+		PlayerTextDrawColor(id, p.textDraw, props.Color)
+	}
+	if p.set&propUseBox != 0 {
+		PlayerTextDrawUseBox(id, p.textDraw, props.UseBox)
+	}
+	if p.set&propBoxColor != 0 {
+		PlayerTextDrawBoxColor(id, p.textDraw, props.BoxColor)
+	}
+	if p.set&propBackgroundColor != 0 {
+		PlayerTextDrawBackgroundColor(id, p.textDraw, props.BackgroundColor)
+	}
+	if p.set&propSelectable != 0 {
+		PlayerTextDrawSetSelectable(id, p.textDraw, props.Selectable)
+	}
+	if p.set&propPreviewModel != 0 {
+		PlayerTextDrawSetPreviewModel(id, p.textDraw, props.PreviewModel)
+	}
+	if p.set&propPreviewRot != 0 {
+		PlayerTextDrawSetPreviewRot(id, p.textDraw, props.PreviewRotX, props.PreviewRotY, props.PreviewRotZ, props.PreviewZoom)
+	}
+	if p.set&propPreviewVehCol != 0 {
+		PlayerTextDrawSetPreviewVehCol(id, p.textDraw, props.PreviewVehColor1, props.PreviewVehColor2)
+	}
+
+	if p.shown {
+		return p.Show()
+	}
+	return nil
 }
 
-func (p *PlayerTextDraw) Show() {
-	PlayerTextDrawShow(p.player.ID, p.textDraw)
+// SetPos moves the textdraw by recreating it.
+func (p *PlayerTextDraw) SetPos(x, y float32) error {
+	p.props.X, p.props.Y = x, y
+	return p.Recreate()
 }
 
-func (p *PlayerTextDraw) Hide() {
-	PlayerTextDrawHide(p.player.ID, p.textDraw)
+func (p *PlayerTextDraw) SetString(text string) error {
+	if err := validateTextDrawString(p.textDraw, text); err != nil {
+		return err
+	}
+	if !PlayerTextDrawSetString(p.player.ID, p.textDraw, text) {
+		return p.err()
+	}
+	p.props.Text = text
+	return nil
 }
 
 func (p *PlayerTextDraw) Font(font int) {
+	p.props.Font = font
+	p.set |= propFont
 	PlayerTextDrawFont(p.player.ID, p.textDraw, font)
 }
 
+func (p *PlayerTextDraw) SetLetterSize(width, height float32) {
+	p.props.LetterWidth, p.props.LetterHeight = width, height
+	p.set |= propLetterSize
+	PlayerTextDrawLetterSize(p.player.ID, p.textDraw, width, height)
+}
+
 func (p *PlayerTextDraw) UseBox(use bool) {
+	p.props.UseBox = use
+	p.set |= propUseBox
 	PlayerTextDrawUseBox(p.player.ID, p.textDraw, use)
 }
 
 func (p *PlayerTextDraw) SetAlignment(align int) {
-	p.align = align
-	PlayerTextDrawAlignment(p.player.ID, p.textDraw, p.align)
+	p.props.Alignment = align
+	p.set |= propAlignment
+	PlayerTextDrawAlignment(p.player.ID, p.textDraw, align)
 }
 
+// SetTextSize sets the size of the box and clickable area. x and y are passed
+// as given, centered textdraws take the height as x and the width as y.
 func (p *PlayerTextDraw) SetTextSize(x, y float32) {
-	if p.align == 2 {
-		x, y = y, x
-	}
+	p.props.TextSizeX, p.props.TextSizeY = x, y
+	p.set |= propTextSize
 	PlayerTextDrawTextSize(p.player.ID, p.textDraw, x, y)
 }
 
+func (p *PlayerTextDraw) SetProportional(proportional bool) {
+	p.props.Proportional = proportional
+	p.set |= propProportional
+	PlayerTextDrawSetProportional(p.player.ID, p.textDraw, proportional)
+}
+
+func (p *PlayerTextDraw) SetShadow(size int) {
+	p.props.Shadow = size
+	p.set |= propShadow
+	PlayerTextDrawSetShadow(p.player.ID, p.textDraw, size)
+}
+
+func (p *PlayerTextDraw) SetOutline(size int) {
+	p.props.Outline = size
+	p.set |= propOutline
+	PlayerTextDrawSetOutline(p.player.ID, p.textDraw, size)
+}
+
 func (p *PlayerTextDraw) SetColor(color int) {
+	p.props.Color = color
+	p.set |= propColor
 	PlayerTextDrawColor(p.player.ID, p.textDraw, color)
 }
 
 var SetColour = (*PlayerTextDraw).SetColor
 
 func (p *PlayerTextDraw) SetBoxColor(color int) {
+	p.props.BoxColor = color
+	p.set |= propBoxColor
 	PlayerTextDrawBoxColor(p.player.ID, p.textDraw, color)
 }
 
 var SetBoxColour = (*PlayerTextDraw).SetBoxColor
 
 func (p *PlayerTextDraw) SetBackgroundColor(color int) {
+	p.props.BackgroundColor = color
+	p.set |= propBackgroundColor
 	PlayerTextDrawBackgroundColor(p.player.ID, p.textDraw, color)
 }
 
 var SetBackgroundColour = (*PlayerTextDraw).SetBackgroundColor
 
 func (p *PlayerTextDraw) SetSelectable(selectable bool) {
+	p.props.Selectable = selectable
+	p.set |= propSelectable
 	PlayerTextDrawSetSelectable(p.player.ID, p.textDraw, selectable)
 }
 
 func (p *PlayerTextDraw) SetPreviewModel(modelindex int) error {
 	if !PlayerTextDrawSetPreviewModel(p.player.ID, p.textDraw, modelindex) {
-		return fmt.Errorf("invalid player or textdraw")
+		return p.err()
 	}
+	p.props.PreviewModel = modelindex
+	p.set |= propPreviewModel
 	return nil
 }
 
 func (p *PlayerTextDraw) SetPreviewRot(rotX, rotY, rotZ, zoom float32) error {
 	if !PlayerTextDrawSetPreviewRot(p.player.ID, p.textDraw, rotX, rotY, rotZ, zoom) {
-		return fmt.Errorf("invalid player or textdraw")
+		return p.err()
 	}
+	p.props.PreviewRotX, p.props.PreviewRotY, p.props.PreviewRotZ, p.props.PreviewZoom = rotX, rotY, rotZ, zoom
+	p.set |= propPreviewRot
 	return nil
 }
 
 func (p *PlayerTextDraw) SetPreviewVehCol(color1, color2 int) error {
 	if !PlayerTextDrawSetPreviewVehCol(p.player.ID, p.textDraw, color1, color2) {
-		return fmt.Errorf("invalid player or textdraw")
+		return p.err()
 	}
+	p.props.PreviewVehColor1, p.props.PreviewVehColor2 = color1, color2
+	p.set |= propPreviewVehCol
 	return nil
 }
+
+// err returns the error for a failed call, telling a disconnected player
+// apart from an invalid textdraw.
+func (p *PlayerTextDraw) err() error {
+	if !IsPlayerConnected(p.player.ID) {
+		return playerError(p.player.ID, ErrPlayerNotConnected)
+	}
+	return textDrawError(p.textDraw, ErrInvalidTextDraw)
+}
+
+// validateTextDrawString checks the length of a textdraw's text. Empty texts
+// crash the client. The error is a textdraw error for id, which is
+// InvalidTextDraw for a textdraw yet to be created.
+func validateTextDrawString(id int, text string) error {
+	if len(text) == 0 || len(text) > MaxTextDrawString {
+		return textDrawError(id, ErrInvalidTextDrawString)
+	}
+	return nil
+}
+
+// TextDraw implements OO global textdraws. It keeps track of the players it
+// is shown for, players disconnecting no longer see it.
+type TextDraw struct {
+	id      int
+	viewers map[int]struct{}
+}
+
+var (
+	textDraws       = make(map[int]*TextDraw)
+	textDrawsHooked bool
+)
+
+func NewTextDraw(x, y float32, text string) (*TextDraw, error) {
+	if err := validateTextDrawString(InvalidTextDraw, text); err != nil {
+		return nil, err
+	}
+
+	td := &TextDraw{id: TextDrawCreate(x, y, text), viewers: make(map[int]struct{})}
+	if td.id == InvalidTextDraw {
+		return nil, fmt.Errorf("can not create textdraw: %w", ErrLimitReached)
+	}
+
+	hookTextDraws()
+	textDraws[td.id] = td
+	return td, nil
+}
+
+func (t *TextDraw) GetID() int {
+	return t.id
+}
+
+// Destroy destroys the textdraw, hiding it for everyone, and removes its click
+// handlers.
+func (t *TextDraw) Destroy() error {
+	if !TextDrawDestroy(t.id) {
+		return textDrawError(t.id, ErrInvalidTextDraw)
+	}
+
+	delete(textDraws, t.id)
+	t.viewers = make(map[int]struct{})
+
+	removeEvent(textDrawEventName(t.id))
+	return nil
+}
+
+func (t *TextDraw) ShowFor(p *Player) error {
+	if !TextDrawShowForPlayer(p.ID, t.id) {
+		return t.err(p)
+	}
+	t.viewers[p.ID] = struct{}{}
+	return nil
+}
+
+func (t *TextDraw) HideFor(p *Player) error {
+	if !TextDrawHideForPlayer(p.ID, t.id) {
+		return t.err(p)
+	}
+	delete(t.viewers, p.ID)
+	return nil
+}
+
+// ShowForAll shows the textdraw for every connected player. Players connecting
+// later do not see it until it is shown for them.
+func (t *TextDraw) ShowForAll() error {
+	if !TextDrawShowForAll(t.id) {
+		return textDrawError(t.id, ErrInvalidTextDraw)
+	}
+	for id := 0; id <= GetPlayerPoolSize(); id++ {
+		if IsPlayerConnected(id) {
+			t.viewers[id] = struct{}{}
+		}
+	}
+	return nil
+}
+
+func (t *TextDraw) HideForAll() error {
+	if !TextDrawHideForAll(t.id) {
+		return textDrawError(t.id, ErrInvalidTextDraw)
+	}
+	t.viewers = make(map[int]struct{})
+	return nil
+}
+
+// IsShownFor reports whether the player currently sees the textdraw.
+func (t *TextDraw) IsShownFor(p *Player) bool {
+	_, ok := t.viewers[p.ID]
+	return ok
+}
+
+// Viewers returns the players currently seeing the textdraw, ordered by ID.
+func (t *TextDraw) Viewers() []Player {
+	viewers := make([]Player, 0, len(t.viewers))
+	for id := range t.viewers {
+		viewers = append(viewers, Player{ID: id})
+	}
+	sort.Slice(viewers, func(i, j int) bool {
+		return viewers[i].ID < viewers[j].ID
+	})
+	return viewers
+}
+
+// Refresh shows the textdraw again for its viewers, which is needed for most
+// changes to a visible textdraw to take effect. SetString and the preview
+// setters update it immediately.
+func (t *TextDraw) Refresh() {
+	for id := range t.viewers {
+		TextDrawShowForPlayer(id, t.id)
+	}
+}
+
+// OnClick registers a handler called when a player clicks the textdraw while
+// selecting textdraws with SelectTextDraw. The textdraw must be selectable.
+func (t *TextDraw) OnClick(handler func(p Player) bool) *Subscription {
+	return insert(textDrawEventName(t.id), &event{Handler: handler, Type: Repeat, Priority: PriorityNormal})
+}
+
+func (t *TextDraw) SetString(text string) error {
+	if err := validateTextDrawString(t.id, text); err != nil {
+		return err
+	}
+	if !TextDrawSetString(t.id, text) {
+		return textDrawError(t.id, ErrInvalidTextDraw)
+	}
+	return nil
+}
+
+func (t *TextDraw) Font(font int) {
+	TextDrawFont(t.id, font)
+}
+
+func (t *TextDraw) SetLetterSize(x, y float32) {
+	TextDrawLetterSize(t.id, x, y)
+}
+
+func (t *TextDraw) UseBox(use bool) {
+	TextDrawUseBox(t.id, use)
+}
+
+func (t *TextDraw) SetAlignment(align int) {
+	TextDrawAlignment(t.id, align)
+}
+
+// SetTextSize sets the size of the box and clickable area. x and y are passed
+// as given, centered textdraws take the height as x and the width as y.
+func (t *TextDraw) SetTextSize(x, y float32) {
+	TextDrawTextSize(t.id, x, y)
+}
+
+func (t *TextDraw) SetShadow(size int) {
+	TextDrawSetShadow(t.id, size)
+}
+
+func (t *TextDraw) SetOutline(size int) {
+	TextDrawSetOutline(t.id, size)
+}
+
+func (t *TextDraw) SetProportional(proportional bool) {
+	TextDrawSetProportional(t.id, proportional)
+}
+
+func (t *TextDraw) SetColor(color int) {
+	TextDrawColor(t.id, color)
+}
+
+func (t *TextDraw) SetBoxColor(color int) {
+	TextDrawBoxColor(t.id, color)
+}
+
+func (t *TextDraw) SetBackgroundColor(color int) {
+	TextDrawBackgroundColor(t.id, color)
+}
+
+func (t *TextDraw) SetSelectable(selectable bool) {
+	TextDrawSetSelectable(t.id, selectable)
+}
+
+func (t *TextDraw) SetPreviewModel(modelindex int) error {
+	if !TextDrawSetPreviewModel(t.id, modelindex) {
+		return textDrawError(t.id, ErrInvalidTextDraw)
+	}
+	return nil
+}
+
+func (t *TextDraw) SetPreviewRot(rotX, rotY, rotZ, zoom float32) error {
+	if !TextDrawSetPreviewRot(t.id, rotX, rotY, rotZ, zoom) {
+		return textDrawError(t.id, ErrInvalidTextDraw)
+	}
+	return nil
+}
+
+func (t *TextDraw) SetPreviewVehCol(color1, color2 int) error {
+	if !TextDrawSetPreviewVehCol(t.id, color1, color2) {
+		return textDrawError(t.id, ErrInvalidTextDraw)
+	}
+	return nil
+}
+
+// err returns the error for a failed call for the player, telling a
+// disconnected player apart from an invalid textdraw.
+func (t *TextDraw) err(p *Player) error {
+	if !IsPlayerConnected(p.ID) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return textDrawError(t.id, ErrInvalidTextDraw)
+}
+
+func textDrawEventName(textDrawID int) string {
+	return fmt.Sprintf("%s%d:click", textDrawPrefix, textDrawID)
+}
+
+// hookTextDraws registers the handlers feeding the click handlers and viewer
+// tracking the first time a textdraw is created.
+func hookTextDraws() {
+	if textDrawsHooked {
+		return
+	}
+	textDrawsHooked = true
+
+	_, _ = OnPlayerClickTextDraw(func(p Player, clickedID int) bool {
+		if clickedID == InvalidTextDraw {
+			return false
+		}
+		return dispatch(textDrawEventName(clickedID), false, func(handler interface{}) (bool, bool) {
+			fn, ok := handler.(func(Player) bool)
+			if !ok {
+				return false, false
+			}
+			return fn(p), true
+		})
+	})
+
+	onPlayerGone(func(playerID int) {
+		for _, td := range textDraws {
+			delete(td.viewers, playerID)
+		}
+	})
+}
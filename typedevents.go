@@ -0,0 +1,283 @@
+package sampgo
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	playerType  = reflect.TypeOf(Player{})
+	vehicleType = reflect.TypeOf(Vehicle{})
+)
+
+// typedHandler calls a handler defined with DefineEvent with typed arguments.
+type typedHandler struct {
+	eventName string
+	evt       *event
+	fn        reflect.Value
+	params    []reflect.Type
+	// asStruct is set when the handler takes all arguments as a single struct.
+	asStruct reflect.Type
+}
+
+// DefineEvent registers a handler with typed arguments for the custom event
+// name, such as
+//
+//	sampgo.DefineEvent("bankDeposit", func(p sampgo.Player, amount int, note string) bool {...})
+//
+// The arguments may also be the exported fields of a single struct:
+//
+//	type BankDeposit struct {
+//		Player sampgo.Player
+//		Amount int
+//		Note   string
+//	}
+//	sampgo.DefineEvent("bankDeposit", func(e BankDeposit) bool {...})
+//
+// Supported argument types are Player, Vehicle, int, int32, int64 (i, d or c),
+// bool (b), float32 and float64 (f), string (s), []int (a[n]) and *int, *int32,
+// *bool and *float32 for by-reference arguments. The handler may return
+// nothing, a value passed back to Pawn, an error, or a value and an error.
+//
+// The event is declared for WriteEventInclude with the format derived from
+// the arguments, unless it has been declared with DeclareEvent before, which
+// is required for array arguments. callEvent checks the format passed by Pawn
+// against the handler's arguments and skips the handler if they do not match.
+func DefineEvent(eventName string, handler interface{}) (*Subscription, error) {
+	if _, ok := eventSignatures[eventName]; ok {
+		return nil, fmt.Errorf("%s is a built-in event, use On instead", eventName)
+	}
+
+	t := reflect.TypeOf(handler)
+	if t == nil || t.Kind() != reflect.Func || reflect.ValueOf(handler).IsNil() {
+		return nil, fmt.Errorf("handler for %s event must be a function, got %T", eventName, handler)
+	}
+	if t.IsVariadic() {
+		return nil, fmt.Errorf("handler for %s event can not be variadic", eventName)
+	}
+	if err := checkResults(t); err != nil {
+		return nil, fmt.Errorf("handler for %s event: %w", eventName, err)
+	}
+
+	h := &typedHandler{eventName: eventName, fn: reflect.ValueOf(handler)}
+	var names []string
+
+	if t.NumIn() == 1 && t.In(0).Kind() == reflect.Struct && t.In(0) != playerType && t.In(0) != vehicleType {
+		h.asStruct = t.In(0)
+		for i := 0; i < h.asStruct.NumField(); i++ {
+			field := h.asStruct.Field(i)
+			if field.PkgPath != "" {
+				return nil, fmt.Errorf("handler for %s event: field %s is not exported", eventName, field.Name)
+			}
+			h.params = append(h.params, field.Type)
+			names = append(names, paramName(field.Type, strings.ToLower(field.Name[:1])+field.Name[1:]))
+		}
+	} else {
+		for i := 0; i < t.NumIn(); i++ {
+			h.params = append(h.params, t.In(i))
+			names = append(names, paramName(t.In(i), fmt.Sprintf("arg%d", i)))
+		}
+	}
+
+	if decl, ok := eventDecls[eventName]; ok {
+		if err := h.check(decl.specs); err != nil {
+			return nil, fmt.Errorf("handler for %s event does not match its declaration %q: %w", eventName, decl.format, err)
+		}
+	} else {
+		var format strings.Builder
+		for i, param := range h.params {
+			spec, err := specFor(param)
+			if err != nil {
+				return nil, fmt.Errorf("handler for %s event: argument %d: %w", eventName, i, err)
+			}
+			format.WriteString(spec)
+		}
+		if err := DeclareEvent(eventName, format.String(), names...); err != nil {
+			return nil, err
+		}
+	}
+
+	h.evt = &event{Handler: h, Type: Repeat, Priority: PriorityNormal}
+	return insert(eventName, h.evt), nil
+}
+
+// checkResults checks that the results of a handler can be passed to Pawn.
+func checkResults(t reflect.Type) error {
+	switch t.NumOut() {
+	case 0, 1:
+		return nil
+	case 2:
+		if t.Out(1) != errorType {
+			return fmt.Errorf("second result must be an error, got %s", t.Out(1))
+		}
+		return nil
+	}
+	return fmt.Errorf("too many results")
+}
+
+// paramName returns the Pawn name of an argument of type t.
+func paramName(t reflect.Type, def string) string {
+	switch t {
+	case playerType:
+		return "playerid"
+	case vehicleType:
+		return "vehicleid"
+	}
+	return def
+}
+
+// specFor returns the format specifier used for an argument of type t.
+func specFor(t reflect.Type) (string, error) {
+	switch t {
+	case playerType, vehicleType:
+		return "i", nil
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return "i", nil
+	case reflect.Bool:
+		return "b", nil
+	case reflect.Float32, reflect.Float64:
+		return "f", nil
+	case reflect.String:
+		return "s", nil
+	case reflect.Slice:
+		return "", fmt.Errorf("declare the size of %s with DeclareEvent first", t)
+	case reflect.Ptr:
+		switch t.Elem().Kind() {
+		case reflect.Int:
+			return "&i", nil
+		case reflect.Int32:
+			return "&c", nil
+		case reflect.Bool:
+			return "&b", nil
+		case reflect.Float32:
+			return "&f", nil
+		}
+	}
+	return "", fmt.Errorf("unsupported type %s", t)
+}
+
+// accepts reports whether an argument of type t can be passed with spec.
+func accepts(t reflect.Type, spec argSpec) bool {
+	if t == playerType || t == vehicleType {
+		return !spec.ref && (spec.kind == 'i' || spec.kind == 'd')
+	}
+
+	if t.Kind() == reflect.Ptr {
+		if !spec.ref {
+			return false
+		}
+		switch spec.kind {
+		case 'i', 'd':
+			return t.Elem().Kind() == reflect.Int
+		case 'c':
+			return t.Elem().Kind() == reflect.Int32
+		case 'b':
+			return t.Elem().Kind() == reflect.Bool
+		case 'f':
+			return t.Elem().Kind() == reflect.Float32
+		}
+		return false
+	}
+
+	if spec.kind == 'a' {
+		return t == reflect.TypeOf([]int(nil))
+	}
+	if spec.ref {
+		return false
+	}
+
+	switch spec.kind {
+	case 'i', 'd', 'c':
+		return t.Kind() == reflect.Int || t.Kind() == reflect.Int32 || t.Kind() == reflect.Int64
+	case 'b':
+		return t.Kind() == reflect.Bool
+	case 'f':
+		return t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+	case 's':
+		return t.Kind() == reflect.String
+	}
+	return false
+}
+
+// check validates the format used by Pawn against the handler's arguments.
+func (h *typedHandler) check(specs []argSpec) error {
+	if len(specs) != len(h.params) {
+		return fmt.Errorf("%d arguments were given, the handler takes %d", len(specs), len(h.params))
+	}
+	for i, spec := range specs {
+		if !accepts(h.params[i], spec) {
+			return fmt.Errorf("argument %d of type %s can not be passed as %q", i, h.params[i], spec.format())
+		}
+	}
+	return nil
+}
+
+// returnsValue reports whether the handler returns a value besides an error.
+func (h *typedHandler) returnsValue() bool {
+	t := h.fn.Type()
+	return t.NumOut() > 1 || t.NumOut() == 1 && t.Out(0) != errorType
+}
+
+// call converts the arguments read by callEvent and calls the handler. A
+// returned error is reported through the error policy and results in false.
+func (h *typedHandler) call(in []interface{}) interface{} {
+	args := make([]reflect.Value, len(in))
+	for i, value := range in {
+		args[i] = convertArg(reflect.ValueOf(value), h.params[i])
+	}
+
+	if h.asStruct != nil {
+		s := reflect.New(h.asStruct).Elem()
+		for i, arg := range args {
+			s.Field(i).Set(arg)
+		}
+		args = []reflect.Value{s}
+	}
+
+	out := h.fn.Call(args)
+
+	if n := len(out); n > 0 && h.fn.Type().Out(n-1) == errorType {
+		if err, _ := out[n-1].Interface().(error); err != nil {
+			handlerFailed(h.eventName, h.evt, err, nil)
+			return false
+		}
+		if n == 1 {
+			return true
+		}
+	}
+
+	if len(out) == 0 {
+		return true
+	}
+	return out[0].Interface()
+}
+
+// convertArg converts a value read by argSpec.read to the type t.
+func convertArg(v reflect.Value, t reflect.Type) reflect.Value {
+	switch t {
+	case playerType:
+		return reflect.ValueOf(Player{ID: int(v.Int())})
+	case vehicleType:
+		return reflect.ValueOf(Vehicle{ID: int(v.Int())})
+	}
+	if v.Type().AssignableTo(t) {
+		return v
+	}
+	return v.Convert(t)
+}
+
+// format returns the specifier of spec as written in a format string.
+func (spec argSpec) format() string {
+	s := string(spec.kind)
+	if spec.kind == 'a' {
+		s += fmt.Sprintf("[%d]", spec.size)
+	}
+	if spec.ref {
+		s = "&" + s
+	}
+	return s
+}
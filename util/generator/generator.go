@@ -34,6 +34,9 @@ type Native struct {
 	ret    string
 	name   string
 	args   []Arg
+	// ugmp is set for the UG-MP natives, which are not part of sampgdk's IDL
+	// and are wrapped as UGMP<name> in natives.go.
+	ugmp bool
 }
 
 type IDL struct {
@@ -246,12 +249,6 @@ func (k Konst) GenGo() string {
 	return "\t" + b.String() + " " + k.T + " = " + k.value + "\n"
 }
 
-func (c *Callback) GenGoBadRet(b *strings.Builder) {
-	b.WriteString("\tif !ok {\n\t\treturn ")
-	b.WriteString(strconv.FormatBool(c.badret))
-	b.WriteString("\n\t}")
-}
-
 func (a *Arg) GoType() string {
 	switch a.T {
 	case "float":
@@ -268,6 +265,14 @@ func (a *Arg) SampGoType() string {
 	return a.GoType()
 }
 
+// HandlerName returns the parameter name used in typed handler signatures.
+func (a *Arg) HandlerName() string {
+	if a.SampGoType() == "Player" {
+		return strings.TrimSuffix(a.name, "id")
+	}
+	return a.name
+}
+
 func (a *Arg) CType() string {
 	if a.T == "string" {
 		return "*C.char_t"
@@ -292,6 +297,37 @@ func (a *Arg) ToC() string {
 	return "C." + a.T + "(" + a.name + ")"
 }
 
+// EventName returns the name the callback's handlers are registered under.
+func (c *Callback) EventName() string {
+	switch c.name {
+	case "OnGameModeInit":
+		return "goModeInit"
+	case "OnGameModeExit":
+		return "goModeExit"
+	}
+
+	rs := []rune(c.name[2:])
+	rs[0] = unicode.ToLower(rs[0])
+	return string(rs)
+}
+
+// HandlerType returns the Go function type of the callback's handlers.
+func (c *Callback) HandlerType() string {
+	var b strings.Builder
+
+	b.WriteString("func(")
+	for i, a := range c.args {
+		if i != 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(a.SampGoType())
+	}
+	b.WriteString(") ")
+	b.WriteString(c.ret)
+
+	return b.String()
+}
+
 func (c *Callback) GenGo() string {
 	var b strings.Builder
 
@@ -300,9 +336,7 @@ func (c *Callback) GenGo() string {
 	rs[0] = unicode.ToLower(rs[0])
 	goname := string(rs)
 
-	rs[2] = unicode.ToLower(rs[2])
-
-	evname := string(rs[2:])
+	evname := c.EventName()
 
 	b.WriteString("//export ")
 	b.WriteString(goname)
@@ -323,34 +357,58 @@ func (c *Callback) GenGo() string {
 	b.WriteString(c.ret)
 	b.WriteString(" {\n")
 
-	b.WriteString("\tevt, ok := events[\"")
+	b.WriteString("\treturn dispatch(\"")
 	b.WriteString(evname)
-	b.WriteString("\"]\n")
-	c.GenGoBadRet(&b)
-	b.WriteString("\n\n\tfn, ok := evt.Handler.(func(")
+	b.WriteString("\", ")
+	b.WriteString(strconv.FormatBool(c.badret))
+	b.WriteString(", func(handler interface{}) (bool, bool) {\n")
+	b.WriteString("\t\tfn, ok := handler.(")
+	b.WriteString(c.HandlerType())
+	b.WriteString(")\n\t\tif !ok {\n\t\t\treturn false, false\n\t\t}\n")
+
+	b.WriteString("\t\treturn fn(")
 	for i, a := range c.args {
 		if i != 0 {
 			b.WriteString(", ")
 		}
-		b.WriteString(a.SampGoType())
+
+		b.WriteString(a.ToGo())
 	}
-	b.WriteString(") ")
-	b.WriteString(c.ret)
-	b.WriteString(")\n")
-	c.GenGoBadRet(&b)
 
-	b.WriteString("\n\tfn(")
+	b.WriteString("), true\n\t})\n}\n\n")
+
+	return b.String()
+}
+
+func (c *Callback) GenGoOn() string {
+	var b strings.Builder
+
+	evname := c.EventName()
+
+	b.WriteString("// ")
+	b.WriteString(c.name)
+	b.WriteString(" registers a handler for the ")
+	b.WriteString(evname)
+	b.WriteString(" event.\n")
+	b.WriteString("// For documentation, please visit https://open.mp/docs/scripting/callbacks/")
+	b.WriteString(c.name)
+	b.WriteString("\nfunc ")
+	b.WriteString(c.name)
+	b.WriteString("(handler func(")
 	for i, a := range c.args {
 		if i != 0 {
 			b.WriteString(", ")
 		}
-
-		b.WriteString(a.ToGo())
+		b.WriteString(a.HandlerName())
+		b.WriteRune(' ')
+		b.WriteString(a.SampGoType())
 	}
-
-	b.WriteString(")\n\treturn ")
-	b.WriteString(strconv.FormatBool(!c.badret))
-	b.WriteString(";\n}\n")
+	b.WriteString(") ")
+	b.WriteString(c.ret)
+	b.WriteString(") (*Subscription, error) {\n")
+	b.WriteString("\treturn On(\"")
+	b.WriteString(evname)
+	b.WriteString("\", handler)\n}\n\n")
 
 	return b.String()
 }
@@ -505,6 +563,292 @@ func (n *Native) GenGo() string {
 	return b.String()
 }
 
+// handWrittenPlayerNatives are wrapped by hand in player.go and skipped by
+// GeneratePlayerMethods.
+var handWrittenPlayerNatives = map[string]bool{
+	"GetPlayerName":             true,
+	"SetPlayerName":             true,
+	"SendClientMessage":         true,
+	"ShowPlayerDialog":          true,
+	"GetPlayerVehicleID":        true,
+	"IsPlayerInVehicle":         true,
+	"IsPlayerInAnyVehicle":      true,
+	"Kick":                      true,
+	"Ban":                       true,
+	"BanEx":                     true,
+	"GetPlayerIp":               true,
+	"NetStats_GetIpPort":        true,
+	"GetPlayerPing":             true,
+	"GetPlayerVersion":          true,
+	"NetStats_GetConnectedTime": true,
+}
+
+// playerMethodNames overrides the method names derived by PlayerMethodName.
+var playerMethodNames = map[string]string{
+	"gpci":                        "GPCI",
+	"SendPlayerMessageToPlayer":   "SendChatTo",
+	"CreatePlayer3DTextLabel":     "CreateTextLabel",
+	"DeletePlayer3DTextLabel":     "DeleteTextLabel",
+	"UpdatePlayer3DTextLabelText": "UpdateTextLabelText",
+}
+
+// playerNativeErrors lists natives that fail for another reason than the
+// player not being connected, with the error returned in that case. The
+// player is checked to be connected first.
+var playerNativeErrors = map[string]string{
+	"GetPVarString": "ErrPVarNotSet",
+	"DeletePVar":    "ErrPVarNotSet",
+}
+
+// ugmpPlayerNatives are the UG-MP natives acting on a player. They are not
+// described by any IDL file, so they are listed here.
+var ugmpPlayerNatives = []Native{
+	ugmpNative("void", "ChangePlayerPedColour", "int colour1", "int colour2", "int colour3", "int colour4"),
+	ugmpNative("void", "TogglePlayerMoon", "bool enable"),
+	ugmpNative("void", "TogglePlayerStars", "bool enable"),
+	ugmpNative("void", "TogglePlayerLowClouds", "bool enable"),
+	ugmpNative("void", "TogglePlayerFluffyClouds", "bool enable"),
+	ugmpNative("void", "TogglePlayerRainbow", "bool enable"),
+	ugmpNative("void", "SetPlayerSeason", "int season"),
+	ugmpNative("int", "IsSASunPositionFormulaEnabled"),
+	ugmpNative("void", "TogglePlayerNightVision", "bool enable"),
+	ugmpNative("void", "TogglePlayerInfraRed", "bool enable"),
+	ugmpNative("void", "TogglePlayerCCTV", "bool enable"),
+	ugmpNative("void", "TogglePlayerFogOverlay", "bool enable"),
+	ugmpNative("void", "TogglePlayerDarknessFilter", "bool enable", "int darknessAlpha"),
+	ugmpNative("void", "TogglePlayerVideoCameraOverlay", "bool enable"),
+	ugmpNative("void", "SetPlayerKnockedOffBikeState", "int knockState"),
+	ugmpNative("void", "SetPlayerFireProof", "bool enable"),
+	ugmpNative("void", "TogglePlayerInfiniteSprint", "bool enable"),
+	ugmpNative("void", "TogglePlayerSun", "bool enable"),
+	ugmpNative("void", "TogglePlayerRubbish", "bool enable"),
+	ugmpNative("bool", "IsRubbishVisibleForPlayer"),
+	ugmpNative("void", "TogglePlayerGrass", "bool enable"),
+	ugmpNative("bool", "SetAircraftHeightLimitForPlayer", "float limit"),
+}
+
+// ugmpNative describes a UG-MP player native, args are given as "T name"
+// after the playerid.
+func ugmpNative(ret, name string, args ...string) Native {
+	n := Native{ret: ret, name: name, ugmp: true, args: []Arg{{T: "int", name: "playerid"}}}
+	for _, arg := range args {
+		fields := strings.Fields(arg)
+		n.args = append(n.args, Arg{T: fields[0], name: fields[1]})
+	}
+	return n
+}
+
+// GoName returns the name of the function wrapping the native in natives.go.
+func (n *Native) GoName() string {
+	if n.ugmp {
+		return "UGMP" + n.name
+	}
+	return n.name
+}
+
+// DocURL returns the documentation of the native.
+func (n *Native) DocURL() string {
+	if n.ugmp {
+		return "https://gtaundergroundmod.com/pages/ug-mp/documentation/native/" + n.name
+	}
+	return "https://open.mp/docs/scripting/functions/" + n.name
+}
+
+// IsPlayerNative reports whether the native acts on the player passed as its
+// first argument and is not covered by another type, such as PlayerTextDraw.
+func (n *Native) IsPlayerNative() bool {
+	if n.noimpl || len(n.args) == 0 || n.args[0].name != "playerid" || n.args[0].T != "int" {
+		return false
+	}
+	if handWrittenPlayerNatives[n.name] {
+		return false
+	}
+	for _, other := range []string{"PlayerTextDraw", "PlayerObject", "GangZone"} {
+		if strings.Contains(n.name, other) {
+			return false
+		}
+	}
+	return !strings.HasPrefix(n.name, "TextDraw")
+}
+
+// PlayerMethodName returns the name of the Player method wrapping the native,
+// such as SetHealth for SetPlayerHealth and GameText for GameTextForPlayer.
+func (n *Native) PlayerMethodName() string {
+	if name, ok := playerMethodNames[n.name]; ok {
+		return name
+	}
+
+	name := strings.ReplaceAll(n.name, "_", "")
+	if strings.Count(name, "Player") == 1 {
+		for _, suffix := range []string{"ForPlayer", "ToPlayer"} {
+			if strings.HasSuffix(name, suffix) {
+				return strings.TrimSuffix(name, suffix)
+			}
+		}
+	}
+	name = strings.Replace(name, "Player", "", 1)
+
+	rs := []rune(name)
+	rs[0] = unicode.ToUpper(rs[0])
+	return string(rs)
+}
+
+// entityArgs maps the names of player and vehicle ID arguments not ending in
+// playerid or vehicleid to their type in Player methods.
+var entityArgs = map[string]string{
+	"senderid":        "*Player",
+	"killer":          "*Player",
+	"killerid":        "*Player",
+	"killee":          "*Player",
+	"killeeid":        "*Player",
+	"attachedplayer":  "*Player",
+	"attachedvehicle": "*Vehicle",
+}
+
+// MethodType returns the type of the argument in Player methods, which take
+// *Player and *Vehicle for player and vehicle IDs, like the hand-written ones.
+func (a *Arg) MethodType() string {
+	if a.T == "int" && !a.out {
+		if t, ok := entityArgs[a.name]; ok {
+			return t
+		}
+		if strings.HasSuffix(a.name, "playerid") {
+			return "*Player"
+		}
+		if strings.HasSuffix(a.name, "vehicleid") {
+			return "*Vehicle"
+		}
+	}
+	return a.GoType()
+}
+
+// IsEntity reports whether the argument is passed as a *Player or *Vehicle.
+func (a *Arg) IsEntity() bool {
+	switch a.MethodType() {
+	case "*Player", "*Vehicle":
+		return true
+	}
+	return false
+}
+
+// MethodName returns the name of the argument in Player methods.
+func (a *Arg) MethodName() string {
+	if a.IsEntity() {
+		return strings.TrimSuffix(a.name, "id")
+	}
+	return a.name
+}
+
+// GenGoPlayerMethod generates the Player method wrapping the native. Failures
+// are reported as errors: natives returning bool fail when they return false,
+// for natives returning a value or nothing the player is checked to be
+// connected first. Predicates named Is* return their bool result as is. String
+// results are read into a buffer of 1024 characters, their size argument is
+// left out. Player and vehicle arguments may be nil to pass the invalid ID.
+func (n *Native) GenGoPlayerMethod() string {
+	var b strings.Builder
+
+	method := n.PlayerMethodName()
+	ret := n.ret
+	if ret == "float" {
+		ret = "float32"
+	}
+	predicate := strings.HasPrefix(n.name, "Is") && ret == "bool"
+
+	var args, outs []Arg
+	for i := 1; i < len(n.args); i++ {
+		a := n.args[i]
+		if a.out {
+			outs = append(outs, a)
+			if a.T == "string" && i+1 < len(n.args) {
+				i++ // the size of the string
+			}
+			continue
+		}
+		args = append(args, a)
+	}
+
+	b.WriteString("// " + method + " calls " + n.GoName() + " for the player.\n")
+	b.WriteString("// For documentation, please visit " + n.DocURL() + "\n")
+	b.WriteString("func (p *Player) " + method + "(")
+	for i, a := range args {
+		if i != 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(a.MethodName() + " " + a.MethodType())
+	}
+	b.WriteString(") ")
+
+	failure, checkConnected := playerNativeErrors[n.name]
+	if !checkConnected {
+		failure = "ErrPlayerNotConnected"
+	}
+
+	switch {
+	case predicate:
+		b.WriteString("bool {\n")
+	case len(outs) > 0:
+		b.WriteString("(")
+		for _, a := range outs {
+			b.WriteString(a.name + " " + a.GoType() + ", ")
+		}
+		b.WriteString("err error) {\n")
+		if checkConnected {
+			b.WriteString("\tif !IsPlayerConnected(p.ID) {\n")
+			b.WriteString("\t\terr = playerError(p.ID, ErrPlayerNotConnected)\n\t\treturn\n\t}\n")
+		}
+	case ret == "bool" || ret == "void":
+		b.WriteString("error {\n")
+		if checkConnected || ret == "void" {
+			b.WriteString("\tif !IsPlayerConnected(p.ID) {\n")
+			b.WriteString("\t\treturn playerError(p.ID, ErrPlayerNotConnected)\n\t}\n")
+		}
+	default:
+		b.WriteString("(" + ret + ", error) {\n")
+		b.WriteString("\tif !IsPlayerConnected(p.ID) {\n")
+		b.WriteString("\t\treturn 0, playerError(p.ID, ErrPlayerNotConnected)\n\t}\n")
+	}
+
+	var call strings.Builder
+	call.WriteString(n.GoName() + "(p.ID")
+	for i := 1; i < len(n.args); i++ {
+		a := n.args[i]
+		call.WriteString(", ")
+		switch {
+		case a.out:
+			call.WriteString("&" + a.name)
+			if a.T == "string" && i+1 < len(n.args) {
+				call.WriteString(", 1024")
+				i++
+			}
+		case a.MethodType() == "*Player":
+			call.WriteString("playerID(" + a.MethodName() + ")")
+		case a.MethodType() == "*Vehicle":
+			call.WriteString("vehicleID(" + a.MethodName() + ")")
+		default:
+			call.WriteString(a.name)
+		}
+	}
+	call.WriteString(")")
+
+	switch {
+	case predicate:
+		b.WriteString("\treturn " + call.String() + "\n}\n\n")
+	case len(outs) > 0:
+		b.WriteString("\tif !" + call.String() + " {\n")
+		b.WriteString("\t\terr = playerError(p.ID, " + failure + ")\n\t}\n\treturn\n}\n\n")
+	case ret == "bool":
+		b.WriteString("\tif !" + call.String() + " {\n")
+		b.WriteString("\t\treturn playerError(p.ID, " + failure + ")\n\t}\n\treturn nil\n}\n\n")
+	case ret == "void":
+		b.WriteString("\t" + call.String() + "\n\treturn nil\n}\n\n")
+	default:
+		b.WriteString("\treturn " + call.String() + ", nil\n}\n\n")
+	}
+
+	return b.String()
+}
+
 const cgoHeader = `package sampgo
 
 /*
@@ -557,6 +901,45 @@ func (idl *IDL) GenerateCallbacks() string {
 
 }
 
+func (idl *IDL) GenerateHandlers() string {
+	var b strings.Builder
+
+	b.WriteString("package sampgo\n\nimport \"reflect\"\n\n")
+
+	b.WriteString("// eventSignatures maps every built-in event to the type its handlers must have.\n")
+	b.WriteString("var eventSignatures = map[string]reflect.Type{\n")
+	b.WriteString("\t\"tick\": reflect.TypeOf((func())(nil)),\n")
+	b.WriteString("\t\"scriptLoad\": reflect.TypeOf((func(*AMX))(nil)),\n")
+	b.WriteString("\t\"scriptUnload\": reflect.TypeOf((func(*AMX))(nil)),\n")
+	for _, c := range idl.callbacks {
+		b.WriteString("\t\"" + c.EventName() + "\": reflect.TypeOf((" + c.HandlerType() + ")(nil)),\n")
+	}
+	b.WriteString("}\n\n")
+
+	for _, c := range idl.callbacks {
+		b.WriteString(c.GenGoOn())
+	}
+
+	return b.String()
+}
+
+func (idl *IDL) GeneratePlayerMethods() string {
+	var b strings.Builder
+
+	b.WriteString("package sampgo\n\n")
+
+	for _, n := range idl.natives {
+		if n.IsPlayerNative() {
+			b.WriteString(n.GenGoPlayerMethod())
+		}
+	}
+	for _, n := range ugmpPlayerNatives {
+		b.WriteString(n.GenGoPlayerMethod())
+	}
+
+	return b.String()
+}
+
 func main() {
 	if len(os.Args) < 2 || len(os.Args[1]) == 0 {
 		println("syntax:", os.Args[0], " [files.idl]")
@@ -576,4 +959,6 @@ func main() {
 	ioutil.WriteFile("../constants.go", []byte(idl.GenerateConstants()), 0666)
 	ioutil.WriteFile("../natives.go", []byte(idl.GenerateNatives()), 0666)
 	ioutil.WriteFile("../callbacks.go", []byte(idl.GenerateCallbacks()), 0666)
+	ioutil.WriteFile("../handlers.go", []byte(idl.GenerateHandlers()), 0666)
+	ioutil.WriteFile("../playernatives.go", []byte(idl.GeneratePlayerMethods()), 0666)
 }
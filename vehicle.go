@@ -16,6 +16,15 @@ type Vehicle struct {
 	ID int
 }
 
+// CarParams holds a parameter for each door or window of a car, one of
+// VehicleParamsUnset, VehicleParamsOff (closed) or VehicleParamsOn (open).
+type CarParams struct {
+	Driver    int
+	Passenger int
+	BackLeft  int
+	BackRight int
+}
+
 type VehicleParams struct {
 	Engine    int
 	Lights    int
@@ -29,25 +38,25 @@ type VehicleParams struct {
 func NewVehicle(modelid int, x, y, z, rotation float32, color1, color2 uint8, respawn_delay int, addsiren bool) (Vehicle, error) {
 	var v Vehicle
 	if !IsValidVehicleModel(modelid) {
-		return v, fmt.Errorf("invalid vehicle model")
+		return v, fmt.Errorf("%w %d", ErrInvalidVehicleModel, modelid)
 	}
 	v.ID = CreateVehicle(modelid, x, y, z, rotation, int(color1), int(color2), respawn_delay, addsiren)
 	if v.ID == InvalidVehicleId {
-		return v, fmt.Errorf("couldn't create vehicle")
+		return v, fmt.Errorf("can not create vehicle: %w", ErrLimitReached)
 	}
 	return v, nil
 }
 
 func (v *Vehicle) Destroy() error {
 	if !DestroyVehicle(v.ID) {
-		return fmt.Errorf("vehicle doesn't exist")
+		return vehicleError(v.ID, ErrInvalidVehicle)
 	}
 	return nil
 }
 
 func (v *Vehicle) SetToRespawn() error {
 	if !SetVehicleToRespawn(v.ID) {
-		return fmt.Errorf("vehicle doesn't exist")
+		return vehicleError(v.ID, ErrInvalidVehicle)
 	}
 	return nil
 
@@ -68,40 +77,309 @@ func (v *Vehicle) GetSpeedInt() int {
 	return int(math.Round(v.GetSpeedFloat64()))
 }
 
+// PutPlayer puts the player in the seat of the vehicle, one of the Seat
+// constants or a passenger seat above them. A negative seat, or one refused by
+// the server, results in ErrInvalidSeat.
 func (v *Vehicle) PutPlayer(p *Player, seat int) error {
-	if !PutPlayerInVehicle(p.ID, v.ID, seat) {
-		return fmt.Errorf("player or vehicle doesn't exist")
+	if !IsValidVehicle(v.ID) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	if !IsPlayerConnected(playerID(p)) {
+		return playerError(playerID(p), ErrPlayerNotConnected)
+	}
+	if seat < 0 || !PutPlayerInVehicle(p.ID, v.ID, seat) {
+		return vehicleError(v.ID, fmt.Errorf("%w %d", ErrInvalidSeat, seat))
 	}
 	return nil
 }
 
-func (v *Vehicle) GetParams() VehicleParams {
-	var params VehicleParams
-	GetVehicleParamsEx(v.ID, &params.Engine, &params.Lights, &params.Alarm, &params.Doors, &params.Bonnet, &params.Boot, &params.Objective)
-	return params
+func (v *Vehicle) GetParams() (params VehicleParams, err error) {
+	if !GetVehicleParamsEx(v.ID, &params.Engine, &params.Lights, &params.Alarm, &params.Doors, &params.Bonnet, &params.Boot, &params.Objective) {
+		err = vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return
 }
 
-func (v *Vehicle) SetParams(params VehicleParams) {
-	SetVehicleParamsEx(v.ID, params.Engine, params.Lights, params.Alarm, params.Doors, params.Bonnet, params.Boot, params.Objective)
+func (v *Vehicle) SetParams(params VehicleParams) error {
+	if !SetVehicleParamsEx(v.ID, params.Engine, params.Lights, params.Alarm, params.Doors, params.Bonnet, params.Boot, params.Objective) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+// SetParamsForPlayer sets whether the vehicle shows an objective arrow and has
+// its doors locked for the player only.
+func (v *Vehicle) SetParamsForPlayer(p *Player, objective, doorsLocked bool) error {
+	if !IsValidVehicle(v.ID) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	var objectiveParam, doorsParam int
+	if objective {
+		objectiveParam = VehicleParamsOn
+	}
+	if doorsLocked {
+		doorsParam = VehicleParamsOn
+	}
+	if !SetVehicleParamsForPlayer(v.ID, p.ID, objectiveParam, doorsParam) {
+		return playerError(p.ID, ErrPlayerNotConnected)
+	}
+	return nil
+}
+
+// GetSirenState returns VehicleParamsOn if the siren is on, VehicleParamsOff
+// if it is off and VehicleParamsUnset if it has not been used yet.
+func (v *Vehicle) GetSirenState() (int, error) {
+	if !IsValidVehicle(v.ID) {
+		return VehicleParamsUnset, vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return GetVehicleParamsSirenState(v.ID), nil
+}
+
+// GetDoors returns which doors are open.
+func (v *Vehicle) GetDoors() (doors CarParams, err error) {
+	if !GetVehicleParamsCarDoors(v.ID, &doors.Driver, &doors.Passenger, &doors.BackLeft, &doors.BackRight) {
+		err = vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return
+}
+
+// SetDoors opens or closes the doors.
+func (v *Vehicle) SetDoors(doors CarParams) error {
+	if !SetVehicleParamsCarDoors(v.ID, doors.Driver, doors.Passenger, doors.BackLeft, doors.BackRight) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+// GetWindows returns which windows are open.
+func (v *Vehicle) GetWindows() (windows CarParams, err error) {
+	if !GetVehicleParamsCarWindows(v.ID, &windows.Driver, &windows.Passenger, &windows.BackLeft, &windows.BackRight) {
+		err = vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return
+}
+
+// SetWindows opens or closes the windows.
+func (v *Vehicle) SetWindows(windows CarParams) error {
+	if !SetVehicleParamsCarWindows(v.ID, windows.Driver, windows.Passenger, windows.BackLeft, windows.BackRight) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
 }
 
 func (v *Vehicle) GetPos() (x, y, z float32, err error) {
 	if !GetVehiclePos(v.ID, &x, &y, &z) {
-		err = fmt.Errorf("invalid vehicle")
+		err = vehicleError(v.ID, ErrInvalidVehicle)
 	}
 	return
 }
 
 func (v *Vehicle) GetZAngle() (zAngle float32, err error) {
 	if !GetVehicleZAngle(v.ID, &zAngle) {
-		err = fmt.Errorf("invalid vehicle")
+		err = vehicleError(v.ID, ErrInvalidVehicle)
 	}
 	return
 }
 
 func (v *Vehicle) GetRotationQuad() (quatW, quatX, quatY, quatZ float32, err error) {
 	if !GetVehicleRotationQuat(v.ID, &quatW, &quatX, &quatY, &quatZ) {
-		err = fmt.Errorf("invalid vehicle")
+		err = vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return
+}
+
+func (v *Vehicle) IsValid() bool {
+	return IsValidVehicle(v.ID)
+}
+
+// IsStreamedIn reports whether the vehicle is streamed in for the player.
+func (v *Vehicle) IsStreamedIn(p *Player) bool {
+	return IsVehicleStreamedIn(v.ID, p.ID)
+}
+
+func (v *Vehicle) SetPos(x, y, z float32) error {
+	if !SetVehiclePos(v.ID, x, y, z) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+func (v *Vehicle) SetZAngle(zAngle float32) error {
+	if !SetVehicleZAngle(v.ID, zAngle) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+func (v *Vehicle) GetDistanceFromPoint(x, y, z float32) (float32, error) {
+	if !IsValidVehicle(v.ID) {
+		return 0, vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return GetVehicleDistanceFromPoint(v.ID, x, y, z), nil
+}
+
+func (v *Vehicle) GetModel() (int, error) {
+	model := GetVehicleModel(v.ID)
+	if model == 0 {
+		return 0, vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return model, nil
+}
+
+func (v *Vehicle) GetHealth() (health float32, err error) {
+	if !GetVehicleHealth(v.ID, &health) {
+		err = vehicleError(v.ID, ErrInvalidVehicle)
 	}
 	return
 }
+
+// SetHealth sets the vehicle's health. Full health is 1000, the vehicle
+// catches fire below 250.
+func (v *Vehicle) SetHealth(health float32) error {
+	if !SetVehicleHealth(v.ID, health) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+// Repair restores full health and removes all visual damage.
+func (v *Vehicle) Repair() error {
+	if !RepairVehicle(v.ID) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+// ChangeColor sets the primary and secondary colours, -1 picks a random one.
+func (v *Vehicle) ChangeColor(color1, color2 int) error {
+	if !ChangeVehicleColor(v.ID, color1, color2) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+// ChangePaintjob applies paintjob 0 to 2, 3 removes it.
+func (v *Vehicle) ChangePaintjob(paintjob int) error {
+	if !ChangeVehiclePaintjob(v.ID, paintjob) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+func (v *Vehicle) AddComponent(component int) error {
+	if !IsValidVehicle(v.ID) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	if !AddVehicleComponent(v.ID, component) {
+		return vehicleError(v.ID, fmt.Errorf("%w %d", ErrInvalidComponent, component))
+	}
+	return nil
+}
+
+func (v *Vehicle) RemoveComponent(component int) error {
+	if !RemoveVehicleComponent(v.ID, component) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+// GetComponentInSlot returns the component installed in slot, one of the
+// Carmodtype constants, or 0 if there is none.
+func (v *Vehicle) GetComponentInSlot(slot int) (int, error) {
+	if !IsValidVehicle(v.ID) {
+		return 0, vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return GetVehicleComponentInSlot(v.ID, slot), nil
+}
+
+// AttachTrailer attaches trailer to the vehicle.
+func (v *Vehicle) AttachTrailer(trailer *Vehicle) error {
+	if !IsValidVehicle(trailer.ID) {
+		return vehicleError(trailer.ID, ErrInvalidVehicle)
+	}
+	if !AttachTrailerToVehicle(trailer.ID, v.ID) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+func (v *Vehicle) DetachTrailer() error {
+	if !DetachTrailerFromVehicle(v.ID) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+func (v *Vehicle) IsTrailerAttached() bool {
+	return IsTrailerAttachedToVehicle(v.ID)
+}
+
+// GetTrailer returns the trailer attached to the vehicle, with ID 0 if there
+// is none.
+func (v *Vehicle) GetTrailer() (trailer Vehicle, err error) {
+	if !IsValidVehicle(v.ID) {
+		return trailer, vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	trailer.ID = GetVehicleTrailer(v.ID)
+	return
+}
+
+// SetNumberPlate sets the number plate, which may contain colour embedding
+// and is shown once the vehicle respawns.
+func (v *Vehicle) SetNumberPlate(plate string) error {
+	if !SetVehicleNumberPlate(v.ID, plate) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+func (v *Vehicle) GetVirtualWorld() (int, error) {
+	if !IsValidVehicle(v.ID) {
+		return 0, vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return GetVehicleVirtualWorld(v.ID), nil
+}
+
+func (v *Vehicle) SetVirtualWorld(world int) error {
+	if !SetVehicleVirtualWorld(v.ID, world) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+func (v *Vehicle) LinkToInterior(interior int) error {
+	if !LinkVehicleToInterior(v.ID, interior) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+func (v *Vehicle) GetVelocity() (x, y, z float32, err error) {
+	if !GetVehicleVelocity(v.ID, &x, &y, &z) {
+		err = vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return
+}
+
+func (v *Vehicle) SetVelocity(x, y, z float32) error {
+	if !SetVehicleVelocity(v.ID, x, y, z) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+func (v *Vehicle) SetAngularVelocity(x, y, z float32) error {
+	if !SetVehicleAngularVelocity(v.ID, x, y, z) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
+
+// vehicleID returns the ID of v, or InvalidVehicleId if v is nil, for natives
+// taking an optional vehicle such as the one a 3D text label is attached to.
+func vehicleID(v *Vehicle) int {
+	if v == nil {
+		return InvalidVehicleId
+	}
+	return v.ID
+}
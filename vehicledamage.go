@@ -0,0 +1,167 @@
+package sampgo
+
+// PanelState is the damage state of a panel, bumper or the windshield.
+type PanelState int
+
+const (
+	PanelUndamaged PanelState = iota
+	// PanelDamaged is a crumpled panel or a cracked windshield.
+	PanelDamaged
+	// PanelLoose is a panel hanging loose.
+	PanelLoose
+	PanelRemoved
+)
+
+// DoorState is the damage state of a door, the bonnet or the boot.
+type DoorState struct {
+	Open    bool
+	Damaged bool
+	Removed bool
+}
+
+// DamageStatus is the visual damage of a vehicle as reported by
+// GetVehicleDamageStatus, decoded from its packed panels, doors, lights and
+// tyres values.
+type DamageStatus struct {
+	FrontLeftPanel  PanelState
+	FrontRightPanel PanelState
+	RearLeftPanel   PanelState
+	RearRightPanel  PanelState
+	Windshield      PanelState
+	FrontBumper     PanelState
+	RearBumper      PanelState
+
+	Bonnet        DoorState
+	Boot          DoorState
+	DriverDoor    DoorState
+	PassengerDoor DoorState
+
+	// The lights are true when broken. Both rear lights break together.
+	FrontLeftLight  bool
+	FrontRightLight bool
+	RearLights      bool
+
+	// The tyres are true when popped. Bikes only use FrontRightTyre for the
+	// front tyre and RearRightTyre for the rear tyre.
+	FrontLeftTyre  bool
+	FrontRightTyre bool
+	RearLeftTyre   bool
+	RearRightTyre  bool
+}
+
+const (
+	doorOpen    = 1
+	doorDamaged = 2
+	doorRemoved = 4
+)
+
+const (
+	lightFrontLeft  = 1 << 0
+	lightFrontRight = 1 << 2
+	lightRear       = 1 << 6
+)
+
+const (
+	tyreRearRight = 1 << iota
+	tyreFrontRight
+	tyreRearLeft
+	tyreFrontLeft
+)
+
+// DecodeDamageStatus decodes the packed values of GetVehicleDamageStatus.
+func DecodeDamageStatus(panels, doors, lights, tyres int) DamageStatus {
+	panel := func(i uint) PanelState {
+		return PanelState(panels >> (i * 4) & 0xF)
+	}
+	door := func(i uint) DoorState {
+		state := doors >> (i * 8) & 0xFF
+		return DoorState{
+			Open:    state&doorOpen != 0,
+			Damaged: state&doorDamaged != 0,
+			Removed: state&doorRemoved != 0,
+		}
+	}
+
+	return DamageStatus{
+		FrontLeftPanel:  panel(0),
+		FrontRightPanel: panel(1),
+		RearLeftPanel:   panel(2),
+		RearRightPanel:  panel(3),
+		Windshield:      panel(4),
+		FrontBumper:     panel(5),
+		RearBumper:      panel(6),
+
+		Bonnet:        door(0),
+		Boot:          door(1),
+		DriverDoor:    door(2),
+		PassengerDoor: door(3),
+
+		FrontLeftLight:  lights&lightFrontLeft != 0,
+		FrontRightLight: lights&lightFrontRight != 0,
+		RearLights:      lights&lightRear != 0,
+
+		FrontLeftTyre:  tyres&tyreFrontLeft != 0,
+		FrontRightTyre: tyres&tyreFrontRight != 0,
+		RearLeftTyre:   tyres&tyreRearLeft != 0,
+		RearRightTyre:  tyres&tyreRearRight != 0,
+	}
+}
+
+// Encode packs the status into the values taken by UpdateVehicleDamageStatus.
+func (d DamageStatus) Encode() (panels, doors, lights, tyres int) {
+	for i, state := range []PanelState{d.FrontLeftPanel, d.FrontRightPanel, d.RearLeftPanel, d.RearRightPanel, d.Windshield, d.FrontBumper, d.RearBumper} {
+		panels |= int(state&0xF) << (uint(i) * 4)
+	}
+
+	for i, state := range []DoorState{d.Bonnet, d.Boot, d.DriverDoor, d.PassengerDoor} {
+		var bits int
+		if state.Open {
+			bits |= doorOpen
+		}
+		if state.Damaged {
+			bits |= doorDamaged
+		}
+		if state.Removed {
+			bits |= doorRemoved
+		}
+		doors |= bits << (uint(i) * 8)
+	}
+
+	for bit, broken := range map[int]bool{lightFrontLeft: d.FrontLeftLight, lightFrontRight: d.FrontRightLight, lightRear: d.RearLights} {
+		if broken {
+			lights |= bit
+		}
+	}
+
+	for bit, popped := range map[int]bool{tyreFrontLeft: d.FrontLeftTyre, tyreFrontRight: d.FrontRightTyre, tyreRearLeft: d.RearLeftTyre, tyreRearRight: d.RearRightTyre} {
+		if popped {
+			tyres |= bit
+		}
+	}
+
+	return
+}
+
+// Undamaged reports whether the vehicle has no visual damage.
+func (d DamageStatus) Undamaged() bool {
+	return d == DamageStatus{}
+}
+
+// GetDamageStatus returns the visual damage of the vehicle.
+func (v *Vehicle) GetDamageStatus() (DamageStatus, error) {
+	var panels, doors, lights, tyres int
+	if !GetVehicleDamageStatus(v.ID, &panels, &doors, &lights, &tyres) {
+		return DamageStatus{}, vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return DecodeDamageStatus(panels, doors, lights, tyres), nil
+}
+
+// UpdateDamageStatus sets the visual damage of the vehicle. Use Repair to
+// restore its health as well.
+func (v *Vehicle) UpdateDamageStatus(status DamageStatus) error {
+	panels, doors, lights, tyres := status.Encode()
+	if !UpdateVehicleDamageStatus(v.ID, panels, doors, lights, tyres) {
+		return vehicleError(v.ID, ErrInvalidVehicle)
+	}
+	return nil
+}
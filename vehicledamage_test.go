@@ -0,0 +1,71 @@
+package sampgo
+
+import "testing"
+
+func TestDamageStatusRoundTrip(t *testing.T) {
+	tests := []struct {
+		name                         string
+		panels, doors, lights, tyres int
+		want                         DamageStatus
+	}{
+		{"undamaged", 0, 0, 0, 0, DamageStatus{}},
+		{"front left panel", 0x1, 0, 0, 0, DamageStatus{FrontLeftPanel: PanelDamaged}},
+		{"rear bumper", 0x3000000, 0, 0, 0, DamageStatus{RearBumper: PanelRemoved}},
+		{"windshield", 0x10000, 0, 0, 0, DamageStatus{Windshield: PanelDamaged}},
+		{"all panels", 0x3213210, 0, 0, 0, DamageStatus{
+			FrontRightPanel: PanelDamaged,
+			RearLeftPanel:   PanelLoose,
+			RearRightPanel:  PanelRemoved,
+			Windshield:      PanelDamaged,
+			FrontBumper:     PanelLoose,
+			RearBumper:      PanelRemoved,
+		}},
+		{"bonnet open", 0, 0x1, 0, 0, DamageStatus{Bonnet: DoorState{Open: true}}},
+		{"boot damaged", 0, 0x200, 0, 0, DamageStatus{Boot: DoorState{Damaged: true}}},
+		{"driver door removed", 0, 0x40000, 0, 0, DamageStatus{DriverDoor: DoorState{Removed: true}}},
+		{"passenger door open and damaged", 0, 0x3000000, 0, 0, DamageStatus{PassengerDoor: DoorState{Open: true, Damaged: true}}},
+		{"front left light", 0, 0, 0x1, 0, DamageStatus{FrontLeftLight: true}},
+		{"front right light", 0, 0, 0x4, 0, DamageStatus{FrontRightLight: true}},
+		{"rear lights", 0, 0, 0x40, 0, DamageStatus{RearLights: true}},
+		{"rear right tyre", 0, 0, 0, 0x1, DamageStatus{RearRightTyre: true}},
+		{"front right tyre", 0, 0, 0, 0x2, DamageStatus{FrontRightTyre: true}},
+		{"rear left tyre", 0, 0, 0, 0x4, DamageStatus{RearLeftTyre: true}},
+		{"front left tyre", 0, 0, 0, 0x8, DamageStatus{FrontLeftTyre: true}},
+		{"wrecked", 0x3333333, 0x04040404, 0x45, 0xF, DamageStatus{
+			FrontLeftPanel:  PanelRemoved,
+			FrontRightPanel: PanelRemoved,
+			RearLeftPanel:   PanelRemoved,
+			RearRightPanel:  PanelRemoved,
+			Windshield:      PanelRemoved,
+			FrontBumper:     PanelRemoved,
+			RearBumper:      PanelRemoved,
+			Bonnet:          DoorState{Removed: true},
+			Boot:            DoorState{Removed: true},
+			DriverDoor:      DoorState{Removed: true},
+			PassengerDoor:   DoorState{Removed: true},
+			FrontLeftLight:  true,
+			FrontRightLight: true,
+			RearLights:      true,
+			FrontLeftTyre:   true,
+			FrontRightTyre:  true,
+			RearLeftTyre:    true,
+			RearRightTyre:   true,
+		}},
+	}
+
+	for _, tt := range tests {
+		got := DecodeDamageStatus(tt.panels, tt.doors, tt.lights, tt.tyres)
+		if got != tt.want {
+			t.Errorf("%s: DecodeDamageStatus(%#x, %#x, %#x, %#x) = %+v, want %+v", tt.name, tt.panels, tt.doors, tt.lights, tt.tyres, got, tt.want)
+		}
+
+		panels, doors, lights, tyres := tt.want.Encode()
+		if panels != tt.panels || doors != tt.doors || lights != tt.lights || tyres != tt.tyres {
+			t.Errorf("%s: Encode() = %#x, %#x, %#x, %#x, want %#x, %#x, %#x, %#x", tt.name, panels, doors, lights, tyres, tt.panels, tt.doors, tt.lights, tt.tyres)
+		}
+	}
+
+	if !DecodeDamageStatus(0, 0, 0, 0).Undamaged() {
+		t.Errorf("DecodeDamageStatus(0, 0, 0, 0).Undamaged() = false")
+	}
+}